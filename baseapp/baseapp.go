@@ -50,7 +50,8 @@ type BaseApp struct {
 	codespacer  *sdk.Codespacer      // handle module codespacing
 	txDecoder   sdk.TxDecoder        // unmarshal []byte into sdk.Tx
 
-	anteHandler sdk.AnteHandler // ante handler for fee and auth
+	anteHandler      sdk.AnteHandler      // ante handler for fee and auth
+	feeRefundHandler sdk.FeeRefundHandler // refunds the fees of unused gas, may be nil
 
 	// may be nil
 	initChainer      sdk.InitChainer  // initialize state with validators and state blob
@@ -59,6 +60,9 @@ type BaseApp struct {
 	addrPeerFilter   sdk.PeerFilter   // filter peers by address and port
 	pubkeyPeerFilter sdk.PeerFilter   // filter peers by public key
 
+	// minimum gas prices this node requires for txs to enter its mempool
+	minimumGasPrices sdk.DecCoins
+
 	//--------------------
 	// Volatile
 	// checkState is set on initialization and reset on Commit.
@@ -184,7 +188,8 @@ func (app *BaseApp) initFromStore(mainKey sdk.StoreKey) error {
 // NewContext returns a new Context with the correct store, the given header, and nil txBytes.
 func (app *BaseApp) NewContext(isCheckTx bool, header abci.Header) sdk.Context {
 	if isCheckTx {
-		return sdk.NewContext(app.checkState.ms, header, true, app.Logger)
+		return sdk.NewContext(app.checkState.ms, header, true, app.Logger).
+			WithMinimumGasPrices(app.minimumGasPrices)
 	}
 	return sdk.NewContext(app.deliverState.ms, header, false, app.Logger)
 }
@@ -202,7 +207,7 @@ func (app *BaseApp) setCheckState(header abci.Header) {
 	ms := app.cms.CacheMultiStore()
 	app.checkState = &state{
 		ms:  ms,
-		ctx: sdk.NewContext(ms, header, true, app.Logger),
+		ctx: sdk.NewContext(ms, header, true, app.Logger).WithMinimumGasPrices(app.minimumGasPrices),
 	}
 }
 
@@ -414,13 +419,13 @@ func (app *BaseApp) BeginBlock(req abci.RequestBeginBlock) (res abci.ResponseBeg
 		app.deliverState.ctx = app.deliverState.ctx.WithBlockHeader(req.Header).WithBlockHeight(req.Header.Height)
 	}
 
-	if app.beginBlocker != nil {
-		res = app.beginBlocker(app.deliverState.ctx, req)
-	}
-
 	// set the signed validators for addition to context in deliverTx
 	// TODO: communicate this result to the address to pubkey map in slashing
 	app.signedValidators = req.LastCommitInfo.GetValidators()
+
+	if app.beginBlocker != nil {
+		res = app.beginBlocker(app.deliverState.ctx.WithSigningValidators(app.signedValidators), req)
+	}
 	return
 }
 
@@ -581,6 +586,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// determined by the GasMeter. We need access to the context to get the gas
 	// meter so we initialize upfront.
 	var gasWanted int64
+	var anteTags sdk.Tags
 	var msCache sdk.CacheMultiStore
 	ctx := app.getContextForAnte(mode, txBytes)
 	ctx = app.initializeContext(ctx, mode)
@@ -617,11 +623,13 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		}
 
 		gasWanted = result.GasWanted
+		anteTags = result.Tags
 	}
 
 	if mode == runTxModeSimulate {
 		result = app.runMsgs(ctx, msgs, mode)
 		result.GasWanted = gasWanted
+		result.Tags = anteTags.AppendTags(result.Tags)
 		return
 	}
 
@@ -634,15 +642,29 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		)).(sdk.CacheMultiStore)
 	}
 
+	anteCtx := ctx
 	ctx = ctx.WithMultiStore(msCache)
 	result = app.runMsgs(ctx, msgs, mode)
 	result.GasWanted = gasWanted
+	result.Tags = anteTags.AppendTags(result.Tags)
 
 	// only update state if all messages pass
 	if result.IsOK() {
 		msCache.Write()
 	}
 
+	// refund the fees of the unused gas like the fees were deducted, outside
+	// of the message state and without charging the tx gas meter. CheckTx
+	// does not run the msg handlers, so its gas used is only the ante gas and
+	// nothing may be refunded.
+	if mode == runTxModeDeliver && app.feeRefundHandler != nil {
+		refundCtx := anteCtx.WithGasMeter(sdk.NewInfiniteGasMeter())
+		refund := app.feeRefundHandler(refundCtx, tx, result)
+		if !refund.IsZero() {
+			result.Tags = result.Tags.AppendTag(sdk.TagFeeRefund, []byte(refund.String()))
+		}
+	}
+
 	return
 }
 
@@ -820,6 +820,138 @@ func TestTxGasLimits(t *testing.T) {
 	}
 }
 
+// Test that the tags of the ante handler are merged into the tx result.
+func TestAnteHandlerTags(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			return ctx, sdk.Result{Tags: sdk.NewTags("ante", []byte("tag"))}, false
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			return sdk.Result{Tags: sdk.NewTags("msg", []byte("tag"))}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+
+	expected := sdk.NewTags("ante", []byte("tag"), "msg", []byte("tag"))
+	res := app.Deliver(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, expected, res.Tags)
+
+	res = app.Simulate(newTxCounter(0, 0))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, expected, res.Tags)
+}
+
+// Test that the fee refund handler is passed the gas used by a tx, and that
+// the refund is tagged without being charged to the tx.
+func TestFeeRefundHandler(t *testing.T) {
+	gasGranted := int64(10)
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(gasGranted))
+			return newCtx, sdk.Result{GasWanted: gasGranted}, false
+		})
+	}
+
+	refundOpt := func(bapp *BaseApp) {
+		bapp.SetFeeRefundHandler(func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) sdk.Coins {
+			// gas consumed while refunding is not charged to the tx
+			ctx.GasMeter().ConsumeGas(100, "refund")
+			return sdk.Coins{sdk.NewInt64Coin("atom", result.GasWanted-result.GasUsed)}
+		})
+	}
+
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			count := msg.(msgCounter).Counter
+			ctx.GasMeter().ConsumeGas(count, "counter-handler")
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, refundOpt, routerOpt)
+
+	app.BeginBlock(abci.RequestBeginBlock{})
+
+	testCases := []struct {
+		tx     *txTest
+		refund string
+	}{
+		{newTxCounter(0, 4), "6atom"},
+		{newTxCounter(0, 1, 1), "8atom"},
+		{newTxCounter(0, 10), ""},
+	}
+
+	for i, tc := range testCases {
+		res := app.Deliver(tc.tx)
+		require.True(t, res.IsOK(), fmt.Sprintf("%d: %v", i, res))
+		require.Equal(t, gasGranted, res.GasWanted)
+
+		var refund string
+		for _, tag := range res.Tags {
+			if string(tag.Key) == sdk.TagFeeRefund {
+				refund = string(tag.Value)
+			}
+		}
+		require.Equal(t, tc.refund, refund, fmt.Sprintf("%d: %v", i, res))
+	}
+}
+
+// Test that fees are only refunded in DeliverTx, where the msgs are run, and
+// not in CheckTx, which only charges the ante gas.
+func TestFeeRefundHandlerDeliverOnly(t *testing.T) {
+	balanceKey := []byte("balance-key")
+	gasGranted := int64(10)
+
+	// the ante handler deducts a fee of 10, the refund handler gives back the
+	// fee of the unused gas
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (newCtx sdk.Context, res sdk.Result, abort bool) {
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, balanceKey, getIntFromStore(store, balanceKey)-gasGranted)
+			newCtx = ctx.WithGasMeter(sdk.NewGasMeter(gasGranted))
+			return newCtx, sdk.Result{GasWanted: gasGranted}, false
+		})
+	}
+	refundOpt := func(bapp *BaseApp) {
+		bapp.SetFeeRefundHandler(func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) sdk.Coins {
+			refund := result.GasWanted - result.GasUsed
+			store := ctx.KVStore(capKey1)
+			setIntOnStore(store, balanceKey, getIntFromStore(store, balanceKey)+refund)
+			return sdk.Coins{sdk.NewInt64Coin("atom", refund)}
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(typeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+			ctx.GasMeter().ConsumeGas(msg.(msgCounter).Counter, "counter-handler")
+			return sdk.Result{}
+		})
+	}
+
+	app := setupBaseApp(t, anteOpt, refundOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	// CheckTx charges the whole fee and refunds nothing
+	res := app.Check(newTxCounter(0, 4))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	for _, tag := range res.Tags {
+		require.NotEqual(t, sdk.TagFeeRefund, string(tag.Key))
+	}
+	require.Equal(t, -gasGranted, getIntFromStore(app.checkState.ctx.KVStore(capKey1), balanceKey))
+
+	// DeliverTx refunds the fee of the gas not used by the msgs
+	app.BeginBlock(abci.RequestBeginBlock{})
+	res = app.Deliver(newTxCounter(0, 4))
+	require.True(t, res.IsOK(), fmt.Sprintf("%v", res))
+	require.Equal(t, int64(-4), getIntFromStore(app.deliverState.ctx.KVStore(capKey1), balanceKey))
+}
+
 //-------------------------------------------------------------------------------------------
 // Queries
 
@@ -26,3 +26,15 @@ func SetPruning(pruning string) func(*BaseApp) {
 		bap.cms.SetPruning(pruningEnum)
 	}
 }
+
+// SetMinimumGasPrices sets the minimum gas prices a node requires for
+// transactions to be accepted into its mempool in CheckTx
+func SetMinimumGasPrices(gasPricesStr string) func(*BaseApp) {
+	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid minimum gas prices: %v", err))
+	}
+	return func(bap *BaseApp) {
+		bap.minimumGasPrices = gasPrices
+	}
+}
@@ -56,6 +56,12 @@ func (app *BaseApp) SetAnteHandler(ah sdk.AnteHandler) {
 	}
 	app.anteHandler = ah
 }
+func (app *BaseApp) SetFeeRefundHandler(frh sdk.FeeRefundHandler) {
+	if app.sealed {
+		panic("SetFeeRefundHandler() on sealed BaseApp")
+	}
+	app.feeRefundHandler = frh
+}
 func (app *BaseApp) SetAddrPeerFilter(pf sdk.PeerFilter) {
 	if app.sealed {
 		panic("SetAddrPeerFilter() on sealed BaseApp")
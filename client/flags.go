@@ -20,6 +20,8 @@ const (
 	FlagSequence      = "sequence"
 	FlagMemo          = "memo"
 	FlagFee           = "fee"
+	FlagFeeGranter    = "fee-granter"
+	FlagTimeoutHeight = "timeout-height"
 	FlagAsync         = "async"
 	FlagJson          = "json"
 	FlagPrintResponse = "print-response"
@@ -50,6 +52,8 @@ func PostCommands(cmds ...*cobra.Command) []*cobra.Command {
 		c.Flags().Int64(FlagSequence, 0, "Sequence number to sign the tx")
 		c.Flags().String(FlagMemo, "", "Memo to send along with transaction")
 		c.Flags().String(FlagFee, "", "Fee to pay along with transaction")
+		c.Flags().Int64(FlagTimeoutHeight, 0, "Block height above which the transaction is rejected, 0 for no timeout")
+		c.Flags().String(FlagFeeGranter, "", "Address of the account which pays the fee from the fee allowance it granted to the signer")
 		c.Flags().String(FlagChainID, "", "Chain ID of tendermint node")
 		c.Flags().String(FlagNode, "tcp://localhost:26657", "<host>:<port> to tendermint rpc interface for this chain")
 		c.Flags().Bool(FlagUseLedger, false, "Use a connected Ledger device")
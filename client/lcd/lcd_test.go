@@ -392,9 +392,7 @@ func TestPoolParamsQuery(t *testing.T) {
 	require.Equal(t, initialPool.DateLastCommissionReset, pool.DateLastCommissionReset)
 	require.Equal(t, initialPool.PrevBondedShares, pool.PrevBondedShares)
 	require.Equal(t, initialPool.BondedTokens, pool.BondedTokens)
-	require.Equal(t, initialPool.NextInflation(params), pool.Inflation)
-	initialPool = initialPool.ProcessProvisions(params) // provisions are added to the pool every hour
-	require.Equal(t, initialPool.LooseTokens, pool.LooseTokens)
+	require.True(t, pool.LooseTokens.GTE(initialPool.LooseTokens)) // provisions are minted every block
 }
 
 func TestValidatorsQuery(t *testing.T) {
@@ -600,7 +598,7 @@ func TestVote(t *testing.T) {
 
 	vote := getVote(t, port, proposalID, addr)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, gov.OptionYes, vote.Option)
+	require.True(t, gov.NewNonSplitVoteOption(gov.OptionYes).Equals(vote.Options))
 }
 
 func TestUnjail(t *testing.T) {
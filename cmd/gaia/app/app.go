@@ -16,8 +16,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	"github.com/cosmos/cosmos-sdk/x/ibc"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
 	"github.com/cosmos/cosmos-sdk/x/stake"
@@ -39,24 +42,33 @@ type GaiaApp struct {
 	cdc *wire.Codec
 
 	// keys to access the substores
-	keyMain          *sdk.KVStoreKey
-	keyAccount       *sdk.KVStoreKey
-	keyIBC           *sdk.KVStoreKey
-	keyStake         *sdk.KVStoreKey
-	keySlashing      *sdk.KVStoreKey
-	keyGov           *sdk.KVStoreKey
-	keyFeeCollection *sdk.KVStoreKey
-	keyParams        *sdk.KVStoreKey
-	tkeyParams       *sdk.TransientStoreKey
+	keyMain     *sdk.KVStoreKey
+	keyAccount  *sdk.KVStoreKey
+	keyBank     *sdk.KVStoreKey
+	keyIBC      *sdk.KVStoreKey
+	keyStake    *sdk.KVStoreKey
+	keySlashing *sdk.KVStoreKey
+	keyMint     *sdk.KVStoreKey
+	keyDistr    *sdk.KVStoreKey
+	keyGov      *sdk.KVStoreKey
+	keyFeeGrant *sdk.KVStoreKey
+	keyParams   *sdk.KVStoreKey
+	tkeyParams  *sdk.TransientStoreKey
 
 	// Manage getting and setting accounts
 	accountMapper       auth.AccountMapper
+	authParamsKeeper    auth.ParamsKeeper
 	feeCollectionKeeper auth.FeeCollectionKeeper
+	supplyKeeper        bank.SupplyKeeper
+	bankParamsKeeper    bank.ParamsKeeper
 	coinKeeper          bank.Keeper
 	ibcMapper           ibc.Mapper
 	stakeKeeper         stake.Keeper
 	slashingKeeper      slashing.Keeper
+	mintKeeper          mint.Keeper
+	distrKeeper         distr.Keeper
 	govKeeper           gov.Keeper
+	feeGrantKeeper      feegrant.Keeper
 	paramsKeeper        params.Keeper
 }
 
@@ -68,17 +80,20 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	bApp.SetCommitMultiStoreTracer(traceStore)
 
 	var app = &GaiaApp{
-		BaseApp:          bApp,
-		cdc:              cdc,
-		keyMain:          sdk.NewKVStoreKey("main"),
-		keyAccount:       sdk.NewKVStoreKey("acc"),
-		keyIBC:           sdk.NewKVStoreKey("ibc"),
-		keyStake:         sdk.NewKVStoreKey("stake"),
-		keySlashing:      sdk.NewKVStoreKey("slashing"),
-		keyGov:           sdk.NewKVStoreKey("gov"),
-		keyFeeCollection: sdk.NewKVStoreKey("fee"),
-		keyParams:        sdk.NewKVStoreKey("params"),
-		tkeyParams:       sdk.NewTransientStoreKey("transient_params"),
+		BaseApp:     bApp,
+		cdc:         cdc,
+		keyMain:     sdk.NewKVStoreKey("main"),
+		keyAccount:  sdk.NewKVStoreKey("acc"),
+		keyBank:     sdk.NewKVStoreKey("bank"),
+		keyIBC:      sdk.NewKVStoreKey("ibc"),
+		keyStake:    sdk.NewKVStoreKey("stake"),
+		keySlashing: sdk.NewKVStoreKey("slashing"),
+		keyMint:     sdk.NewKVStoreKey("mint"),
+		keyDistr:    sdk.NewKVStoreKey("distr"),
+		keyGov:      sdk.NewKVStoreKey("gov"),
+		keyFeeGrant: sdk.NewKVStoreKey("feegrant"),
+		keyParams:   sdk.NewKVStoreKey("params"),
+		tkeyParams:  sdk.NewTransientStoreKey("transient_params"),
 	}
 
 	// define the accountMapper
@@ -89,13 +104,29 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 	)
 
 	// add handlers
-	app.coinKeeper = bank.NewKeeper(app.accountMapper)
-	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
 	app.paramsKeeper = params.NewKeeper(app.cdc, app.keyParams)
-	app.stakeKeeper = stake.NewKeeper(app.cdc, app.keyStake, app.coinKeeper, app.RegisterCodespace(stake.DefaultCodespace))
-	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper.Setter(), app.coinKeeper, app.stakeKeeper, app.RegisterCodespace(gov.DefaultCodespace))
-	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.cdc, app.keyFeeCollection)
+	app.authParamsKeeper = auth.NewParamsKeeper(app.cdc, app.paramsKeeper.Setter())
+	app.supplyKeeper = bank.NewSupplyKeeper(app.cdc, app.keyBank)
+	app.bankParamsKeeper = bank.NewParamsKeeper(app.cdc, app.paramsKeeper.Setter())
+	app.coinKeeper = bank.NewKeeper(app.accountMapper).
+		WithSupplyKeeper(app.supplyKeeper).
+		WithParamsKeeper(app.bankParamsKeeper).
+		WithModuleAccounts(auth.FeeCollectorName, distr.ModuleName, gov.ModuleName, ibc.ModuleName)
+	app.ibcMapper = ibc.NewMapper(app.cdc, app.keyIBC, app.RegisterCodespace(ibc.DefaultCodespace))
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountMapper)
+	stakeKeeper := stake.NewKeeper(app.cdc, app.keyStake, app.coinKeeper, app.RegisterCodespace(stake.DefaultCodespace))
+	app.coinKeeper = app.coinKeeper.WithStakeKeeper(stakeKeeper)
+	app.distrKeeper = distr.NewKeeper(app.cdc, app.keyDistr, app.coinKeeper, stakeKeeper, app.feeCollectionKeeper, app.RegisterCodespace(distr.DefaultCodespace))
+	app.stakeKeeper = stakeKeeper.WithHooks(app.distrKeeper.Hooks())
+	app.mintKeeper = mint.NewKeeper(app.cdc, app.keyMint, app.stakeKeeper, app.coinKeeper, app.feeCollectionKeeper)
+	app.govKeeper = gov.NewKeeper(app.cdc, app.keyGov, app.paramsKeeper.Setter(), app.coinKeeper, app.stakeKeeper, app.distrKeeper, app.RegisterCodespace(gov.DefaultCodespace)).
+		WithChangeableParams(
+			gov.NewChangeableParam(auth.ParamStoreKeyParams, auth.ValidateParamsChange),
+			gov.NewChangeableParam(bank.ParamStoreKeyParams, bank.ValidateParamsChange),
+			gov.NewChangeableParam(bank.ParamStoreKeyDenomMetadata, bank.ValidateDenomMetadataChange),
+		)
 	app.slashingKeeper = slashing.NewKeeper(app.cdc, app.keySlashing, app.stakeKeeper, app.paramsKeeper.Getter(), app.RegisterCodespace(slashing.DefaultCodespace))
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, app.keyFeeGrant, app.RegisterCodespace(feegrant.DefaultCodespace))
 
 	// register message routes
 	app.Router().
@@ -103,17 +134,25 @@ func NewGaiaApp(logger log.Logger, db dbm.DB, traceStore io.Writer, baseAppOptio
 		AddRoute("ibc", ibc.NewHandler(app.ibcMapper, app.coinKeeper)).
 		AddRoute("stake", stake.NewHandler(app.stakeKeeper)).
 		AddRoute("slashing", slashing.NewHandler(app.slashingKeeper)).
-		AddRoute("gov", gov.NewHandler(app.govKeeper))
+		AddRoute("distr", distr.NewHandler(app.distrKeeper)).
+		AddRoute("gov", gov.NewHandler(app.govKeeper)).
+		AddRoute("feegrant", feegrant.NewHandler(app.feeGrantKeeper))
 
 	app.QueryRouter().
-		AddRoute("gov", gov.NewQuerier(app.govKeeper))
+		AddRoute("auth", auth.NewQuerier(app.authParamsKeeper)).
+		AddRoute("bank", bank.NewQuerier(app.coinKeeper)).
+		AddRoute("gov", gov.NewQuerier(app.govKeeper)).
+		AddRoute("distr", distr.NewQuerier(app.distrKeeper)).
+		AddRoute("mint", mint.NewQuerier(app.mintKeeper)).
+		AddRoute("feegrant", feegrant.NewQuerier(app.feeGrantKeeper))
 
 	// initialize BaseApp
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountMapper, app.feeCollectionKeeper))
-	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyIBC, app.keyStake, app.keySlashing, app.keyGov, app.keyFeeCollection, app.keyParams)
+	app.SetAnteHandler(auth.NewAnteHandlerWithParams(app.accountMapper, app.feeCollectionKeeper, app.feeGrantKeeper, app.authParamsKeeper))
+	app.SetFeeRefundHandler(auth.NewFeeRefundHandler(app.accountMapper, app.feeCollectionKeeper, app.feeGrantKeeper, app.authParamsKeeper))
+	app.MountStoresIAVL(app.keyMain, app.keyAccount, app.keyBank, app.keyIBC, app.keyStake, app.keySlashing, app.keyGov, app.keyParams, app.keyDistr, app.keyMint, app.keyFeeGrant)
 	app.MountStore(app.tkeyParams, sdk.StoreTypeTransient)
 	err := app.LoadLatestVersion(app.keyMain)
 	if err != nil {
@@ -131,6 +170,8 @@ func MakeCodec() *wire.Codec {
 	stake.RegisterWire(cdc)
 	slashing.RegisterWire(cdc)
 	gov.RegisterWire(cdc)
+	distr.RegisterWire(cdc)
+	feegrant.RegisterWire(cdc)
 	auth.RegisterWire(cdc)
 	sdk.RegisterWire(cdc)
 	wire.RegisterCrypto(cdc)
@@ -139,8 +180,18 @@ func MakeCodec() *wire.Codec {
 
 // application updates every end block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	// halt at the height of a scheduled upgrade, unless this binary handles it
+	gov.BeginBlocker(ctx, app.govKeeper)
+
 	tags := slashing.BeginBlocker(ctx, req, app.slashingKeeper)
 
+	// mint the inflation provisions of this block into the fee collector
+	mint.BeginBlocker(ctx, app.mintKeeper)
+
+	// distribute the fees collected in the previous block along with the
+	// provisions
+	distr.BeginBlocker(ctx, app.distrKeeper)
+
 	return abci.ResponseBeginBlock{
 		Tags: tags.ToKVPairs(),
 	}
@@ -173,11 +224,21 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 
 	// load the accounts
 	for _, gacc := range genesisState.Accounts {
+		err = gacc.validateVesting()
+		if err != nil {
+			panic(err) // TODO https://github.com/cosmos/cosmos-sdk/issues/468
+		}
 		acc := gacc.ToAccount()
-		acc.AccountNumber = app.accountMapper.GetNextAccountNumber(ctx)
+		err = acc.SetAccountNumber(app.accountMapper.GetNextAccountNumber(ctx))
+		if err != nil {
+			panic(err)
+		}
 		app.accountMapper.SetAccount(ctx, acc)
 	}
 
+	// load the auth params
+	auth.InitGenesis(ctx, app.authParamsKeeper, genesisState.AuthData)
+
 	// load the initial stake information
 	validators, err := stake.InitGenesis(ctx, app.stakeKeeper, genesisState.StakeData)
 	if err != nil {
@@ -189,12 +250,43 @@ func (app *GaiaApp) initChainer(ctx sdk.Context, req abci.RequestInitChain) abci
 	slashing.InitGenesis(ctx, app.slashingKeeper, genesisState.StakeData)
 
 	gov.InitGenesis(ctx, app.govKeeper, genesisState.GovData)
+	distr.InitGenesis(ctx, app.distrKeeper, genesisState.DistrData)
+	mint.InitGenesis(ctx, app.mintKeeper, genesisState.MintData)
+	feegrant.InitGenesis(ctx, app.feeGrantKeeper, genesisState.FeeGrantData)
+
+	// load the total supply, counting it for a new chain
+	bankData := genesisState.BankData
+	if bankData.Supply.IsZero() {
+		bankData.Supply = app.genesisSupply(ctx)
+	}
+	bank.InitGenesis(ctx, app.supplyKeeper, app.bankParamsKeeper, bankData)
 
 	return abci.ResponseInitChain{
 		Validators: validators,
 	}
 }
 
+// the total supply of a new chain, the coins of the genesis accounts and the
+// staking tokens bonded to the genesis validators
+func (app *GaiaApp) genesisSupply(ctx sdk.Context) sdk.Coins {
+	supply := sdk.Coins{}
+	app.accountMapper.IterateAccounts(ctx, func(acc auth.Account) (stop bool) {
+		supply = supply.Plus(acc.GetCoins())
+		return false
+	})
+
+	bonded := sdk.ZeroDec()
+	app.stakeKeeper.IterateValidators(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
+		bonded = bonded.Add(validator.GetTokens())
+		return false
+	})
+	if bonded.IsZero() {
+		return supply
+	}
+	bondDenom := app.stakeKeeper.GetParams(ctx).BondDenom
+	return supply.Plus(sdk.Coins{sdk.NewCoin(bondDenom, bonded.RoundInt())})
+}
+
 // export the state of gaia for a genesis file
 func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, validators []tmtypes.GenesisValidator, err error) {
 	ctx := app.NewContext(true, abci.Header{})
@@ -209,9 +301,14 @@ func (app *GaiaApp) ExportAppStateAndValidators() (appState json.RawMessage, val
 	app.accountMapper.IterateAccounts(ctx, appendAccount)
 
 	genState := GenesisState{
-		Accounts:  accounts,
-		StakeData: stake.WriteGenesis(ctx, app.stakeKeeper),
-		GovData:   gov.WriteGenesis(ctx, app.govKeeper),
+		Accounts:     accounts,
+		AuthData:     auth.WriteGenesis(ctx, app.authParamsKeeper),
+		BankData:     bank.WriteGenesis(ctx, app.supplyKeeper, app.bankParamsKeeper),
+		StakeData:    stake.WriteGenesis(ctx, app.stakeKeeper),
+		GovData:      gov.WriteGenesis(ctx, app.govKeeper),
+		DistrData:    distr.WriteGenesis(ctx, app.distrKeeper),
+		MintData:     mint.WriteGenesis(ctx, app.mintKeeper),
+		FeeGrantData: feegrant.WriteGenesis(ctx, app.feeGrantKeeper),
 	}
 	appState, err = wire.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
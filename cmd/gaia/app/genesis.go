@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/server"
@@ -11,7 +12,11 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 
 	"github.com/spf13/pflag"
@@ -31,15 +36,32 @@ var (
 
 // State to Unmarshal
 type GenesisState struct {
-	Accounts  []GenesisAccount   `json:"accounts"`
-	StakeData stake.GenesisState `json:"stake"`
-	GovData   gov.GenesisState   `json:"gov"`
+	Accounts     []GenesisAccount      `json:"accounts"`
+	AuthData     auth.GenesisState     `json:"auth"`
+	BankData     bank.GenesisState     `json:"bank"`
+	StakeData    stake.GenesisState    `json:"stake"`
+	GovData      gov.GenesisState      `json:"gov"`
+	DistrData    distr.GenesisState    `json:"distr"`
+	MintData     mint.GenesisState     `json:"mint"`
+	FeeGrantData feegrant.GenesisState `json:"feegrant"`
 }
 
 // GenesisAccount doesn't need pubkey or sequence
 type GenesisAccount struct {
 	Address sdk.AccAddress `json:"address"`
 	Coins   sdk.Coins      `json:"coins"`
+
+	// vesting account fields, the account vests continuously if it has a
+	// start time and at the end time otherwise
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins vesting at genesis
+	DelegatedFree    sdk.Coins `json:"delegated_free"`    // vested coins which are delegated
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // vesting coins which are delegated
+	StartTime        time.Time `json:"start_time"`        // vesting start time
+	EndTime          time.Time `json:"end_time"`          // vesting end time
+
+	// module account fields, the account is owned by the named module
+	ModuleName       string `json:"module_name,omitempty"`       // name of the module owning the account
+	ModulePermission string `json:"module_permission,omitempty"` // permission of the module over the coins
 }
 
 func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
@@ -50,18 +72,78 @@ func NewGenesisAccount(acc *auth.BaseAccount) GenesisAccount {
 }
 
 func NewGenesisAccountI(acc auth.Account) GenesisAccount {
-	return GenesisAccount{
+	gacc := GenesisAccount{
 		Address: acc.GetAddress(),
 		Coins:   acc.GetCoins(),
 	}
+
+	vacc, ok := acc.(auth.VestingAccount)
+	if ok {
+		gacc.OriginalVesting = vacc.GetOriginalVesting()
+		gacc.DelegatedFree = vacc.GetDelegatedFree()
+		gacc.DelegatedVesting = vacc.GetDelegatedVesting()
+		gacc.StartTime = vacc.GetStartTime()
+		gacc.EndTime = vacc.GetEndTime()
+	}
+
+	macc, ok := acc.(*auth.ModuleAccount)
+	if ok {
+		gacc.ModuleName = macc.Name
+		gacc.ModulePermission = macc.Permission
+	}
+	return gacc
 }
 
-// convert GenesisAccount to auth.BaseAccount
-func (ga *GenesisAccount) ToAccount() (acc *auth.BaseAccount) {
-	return &auth.BaseAccount{
+// convert GenesisAccount to auth.Account, a module account if the genesis
+// account is owned by a module and a vesting account if it has original
+// vesting coins
+func (ga *GenesisAccount) ToAccount() auth.Account {
+	bacc := &auth.BaseAccount{
 		Address: ga.Address,
 		Coins:   ga.Coins.Sort(),
 	}
+
+	if ga.ModuleName != "" {
+		return auth.NewModuleAccount(bacc, ga.ModuleName, ga.ModulePermission)
+	}
+	if ga.OriginalVesting.IsZero() {
+		return bacc
+	}
+
+	baseVestingAcc := &auth.BaseVestingAccount{
+		BaseAccount:      bacc,
+		OriginalVesting:  ga.OriginalVesting.Sort(),
+		DelegatedFree:    ga.DelegatedFree.Sort(),
+		DelegatedVesting: ga.DelegatedVesting.Sort(),
+		EndTime:          ga.EndTime,
+	}
+	if !ga.StartTime.IsZero() {
+		return &auth.ContinuousVestingAccount{
+			BaseVestingAccount: baseVestingAcc,
+			StartTime:          ga.StartTime,
+		}
+	}
+	return &auth.DelayedVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+	}
+}
+
+// validate the vesting fields of the genesis account
+func (ga *GenesisAccount) validateVesting() error {
+	if ga.OriginalVesting.IsZero() {
+		if !ga.DelegatedFree.IsZero() || !ga.DelegatedVesting.IsZero() ||
+			!ga.StartTime.IsZero() || !ga.EndTime.IsZero() {
+			return fmt.Errorf("genesis account %s has vesting fields but no original vesting coins", ga.Address)
+		}
+		return nil
+	}
+	if !ga.OriginalVesting.IsPositive() {
+		return fmt.Errorf("genesis account %s has non-positive original vesting coins", ga.Address)
+	}
+	if ga.EndTime.IsZero() || ga.EndTime.Before(ga.StartTime) {
+		return fmt.Errorf("genesis account %s vesting ends before it starts", ga.Address)
+	}
+	return nil
 }
 
 // get app init parameters for server init command
@@ -216,9 +298,14 @@ func GaiaAppGenState(cdc *wire.Codec, appGenTxs []json.RawMessage) (genesisState
 
 	// create the final app state
 	genesisState = GenesisState{
-		Accounts:  genaccs,
-		StakeData: stakeData,
-		GovData:   gov.DefaultGenesisState(),
+		Accounts:     genaccs,
+		AuthData:     auth.DefaultGenesisState(),
+		BankData:     bank.DefaultGenesisState(),
+		StakeData:    stakeData,
+		GovData:      gov.DefaultGenesisState(),
+		DistrData:    distr.DefaultGenesisState(),
+		MintData:     mint.DefaultGenesisState(),
+		FeeGrantData: feegrant.DefaultGenesisState(),
 	}
 	return
 }
@@ -2,6 +2,7 @@ package app
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -14,7 +15,39 @@ func TestToAccount(t *testing.T) {
 	addr := sdk.AccAddress(priv.PubKey().Address())
 	authAcc := auth.NewBaseAccountWithAddress(addr)
 	genAcc := NewGenesisAccount(&authAcc)
-	require.Equal(t, authAcc, *genAcc.ToAccount())
+	require.Equal(t, &authAcc, genAcc.ToAccount())
+}
+
+func TestToVestingAccount(t *testing.T) {
+	priv := ed25519.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	coins := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	startTime := time.Unix(1000, 0).UTC()
+	endTime := time.Unix(2000, 0).UTC()
+
+	// continuous vesting with a start time
+	authAcc := auth.NewBaseAccountWithAddress(addr)
+	authAcc.Coins = coins
+	cva := auth.NewContinuousVestingAccount(&authAcc, startTime, endTime)
+	genAcc := NewGenesisAccountI(cva)
+	require.Nil(t, genAcc.validateVesting())
+	require.Equal(t, cva, genAcc.ToAccount())
+
+	// delayed vesting without a start time
+	dva := auth.NewDelayedVestingAccount(&authAcc, endTime)
+	genAcc = NewGenesisAccountI(dva)
+	require.Nil(t, genAcc.validateVesting())
+	require.Equal(t, dva, genAcc.ToAccount())
+
+	// vesting must end after it starts
+	genAcc = NewGenesisAccountI(cva)
+	genAcc.EndTime = time.Unix(500, 0).UTC()
+	require.NotNil(t, genAcc.validateVesting())
+
+	// vesting fields require original vesting coins
+	genAcc = NewGenesisAccount(&authAcc)
+	genAcc.EndTime = endTime
+	require.NotNil(t, genAcc.validateVesting())
 }
 
 func TestGaiaAppGenTx(t *testing.T) {
@@ -14,8 +14,12 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	distr "github.com/cosmos/cosmos-sdk/x/distribution"
 	govsim "github.com/cosmos/cosmos-sdk/x/gov/simulation"
+	"github.com/cosmos/cosmos-sdk/x/mint"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	slashingsim "github.com/cosmos/cosmos-sdk/x/slashing/simulation"
 	stake "github.com/cosmos/cosmos-sdk/x/stake"
@@ -72,7 +76,11 @@ func appStateFn(r *rand.Rand, keys []crypto.PrivKey, accs []sdk.AccAddress) json
 	stakeGenesis.Params.InflationMin = sdk.NewDec(0)
 	genesis := GenesisState{
 		Accounts:  genesisAccounts,
+		AuthData:  auth.DefaultGenesisState(),
+		BankData:  bank.DefaultGenesisState(),
 		StakeData: stakeGenesis,
+		DistrData: distr.DefaultGenesisState(),
+		MintData:  mint.DefaultGenesisState(),
 	}
 
 	// Marshal genesis
@@ -90,6 +98,7 @@ func testAndRunTxs(app *GaiaApp) []simulation.Operation {
 		govsim.SimulateMsgSubmitProposal(app.govKeeper, app.stakeKeeper),
 		govsim.SimulateMsgDeposit(app.govKeeper, app.stakeKeeper),
 		govsim.SimulateMsgVote(app.govKeeper, app.stakeKeeper),
+		govsim.SimulateMsgVoteWeighted(app.govKeeper, app.stakeKeeper),
 		stakesim.SimulateMsgCreateValidator(app.accountMapper, app.stakeKeeper),
 		stakesim.SimulateMsgEditValidator(app.stakeKeeper),
 		stakesim.SimulateMsgDelegate(app.accountMapper, app.stakeKeeper),
@@ -101,10 +110,24 @@ func testAndRunTxs(app *GaiaApp) []simulation.Operation {
 	}
 }
 
+// the coins held outside of accounts, the staking tokens bonded to validators
+// or in unbonding delegations; collected fees, undistributed rewards and gov
+// deposits are held by module accounts
+func moduleHoldings(app *GaiaApp) func(ctx sdk.Context) sdk.Coins {
+	return func(ctx sdk.Context) sdk.Coins {
+		held := app.stakeKeeper.GetHeldTokens(ctx)
+		if held.IsZero() {
+			return sdk.Coins{}
+		}
+		return sdk.Coins{sdk.NewCoin(app.stakeKeeper.GetParams(ctx).BondDenom, held)}
+	}
+}
+
 func invariants(app *GaiaApp) []simulation.Invariant {
 	return []simulation.Invariant{
 		func(t *testing.T, baseapp *baseapp.BaseApp, log string) {
 			banksim.NonnegativeBalanceInvariant(app.accountMapper)(t, baseapp, log)
+			banksim.SupplyInvariant(app.accountMapper, app.supplyKeeper, moduleHoldings(app))(t, baseapp, log)
 			govsim.AllInvariants()(t, baseapp, log)
 			stakesim.AllInvariants(app.coinKeeper, app.stakeKeeper, app.accountMapper)(t, baseapp, log)
 			slashingsim.AllInvariants()(t, baseapp, log)
@@ -137,7 +137,6 @@ func TestGaiaCLICreateValidator(t *testing.T) {
 	defaultParams := stake.DefaultParams()
 	initialPool := stake.InitialPool()
 	initialPool.BondedTokens = initialPool.BondedTokens.Add(sdk.NewDec(100)) // Delegate tx on GaiaAppGenState
-	initialPool = initialPool.ProcessProvisions(defaultParams)               // provisions are added to the pool every hour
 
 	// create validator
 	cvStr := fmt.Sprintf("gaiacli stake create-validator %v", flags)
@@ -248,12 +247,12 @@ func TestGaiaCLISubmitProposal(t *testing.T) {
 
 	vote := executeGetVote(t, fmt.Sprintf("gaiacli gov query-vote --proposal-id=1 --voter=%s --output=json %v", fooAddr, flags))
 	require.Equal(t, int64(1), vote.ProposalID)
-	require.Equal(t, gov.OptionYes, vote.Option)
+	require.True(t, gov.NewNonSplitVoteOption(gov.OptionYes).Equals(vote.Options))
 
 	votes := executeGetVotes(t, fmt.Sprintf("gaiacli gov query-votes --proposal-id=1 --output=json %v", flags))
 	require.Len(t, votes, 1)
 	require.Equal(t, int64(1), votes[0].ProposalID)
-	require.Equal(t, gov.OptionYes, votes[0].Option)
+	require.True(t, gov.NewNonSplitVoteOption(gov.OptionYes).Equals(votes[0].Options))
 
 	proposalsQuery = tests.ExecuteT(t, fmt.Sprintf("gaiacli gov query-proposals --status=DepositPeriod %v", flags), "")
 	require.Equal(t, "No matching proposals found", proposalsQuery)
@@ -13,8 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/version"
 	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
 	bankcmd "github.com/cosmos/cosmos-sdk/x/bank/client/cli"
+	distrcmd "github.com/cosmos/cosmos-sdk/x/distribution/client/cli"
+	feegrantcmd "github.com/cosmos/cosmos-sdk/x/feegrant/client/cli"
 	govcmd "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
 	ibccmd "github.com/cosmos/cosmos-sdk/x/ibc/client/cli"
+	mintcmd "github.com/cosmos/cosmos-sdk/x/mint/client/cli"
 	slashingcmd "github.com/cosmos/cosmos-sdk/x/slashing/client/cli"
 	stakecmd "github.com/cosmos/cosmos-sdk/x/stake/client/cli"
 
@@ -87,6 +90,12 @@ func main() {
 			stakecmd.GetCmdQueryRedelegation("stake", cdc),
 			stakecmd.GetCmdQueryRedelegations("stake", cdc),
 			slashingcmd.GetCmdQuerySigningInfo("slashing", cdc),
+			distrcmd.GetCmdQueryRewards("distr", cdc),
+			distrcmd.GetCmdQueryCommission("distr", cdc),
+			distrcmd.GetCmdQueryWithdrawAddr("distr", cdc),
+			distrcmd.GetCmdQueryCommunityPool("distr", cdc),
+			mintcmd.GetCmdQueryInflation("mint", cdc),
+			mintcmd.GetCmdQueryAnnualProvisions("mint", cdc),
 		)...)
 	stakeCmd.AddCommand(
 		client.PostCommands(
@@ -96,6 +105,9 @@ func main() {
 			stakecmd.GetCmdUnbond("stake", cdc),
 			stakecmd.GetCmdRedelegate("stake", cdc),
 			slashingcmd.GetCmdUnjail(cdc),
+			distrcmd.GetCmdSetWithdrawAddr(cdc),
+			distrcmd.GetCmdWithdrawRewards(cdc),
+			distrcmd.GetCmdWithdrawCommission(cdc),
 		)...)
 	rootCmd.AddCommand(
 		stakeCmd,
@@ -112,25 +124,53 @@ func main() {
 			govcmd.GetCmdQueryVote("gov", cdc),
 			govcmd.GetCmdQueryVotes("gov", cdc),
 			govcmd.GetCmdQueryProposals("gov", cdc),
+			govcmd.GetCmdQueryUpgradePlan("gov", cdc),
+			govcmd.GetCmdQueryAppliedUpgrade("gov", cdc),
 		)...)
 	govCmd.AddCommand(
 		client.PostCommands(
 			govcmd.GetCmdSubmitProposal(cdc),
 			govcmd.GetCmdDeposit(cdc),
 			govcmd.GetCmdVote(cdc),
+			govcmd.GetCmdVoteWeighted(cdc),
 		)...)
 	rootCmd.AddCommand(
 		govCmd,
 	)
 
+	//Add fee grant commands
+	feegrantCmd := &cobra.Command{
+		Use:   "feegrant",
+		Short: "Fee allowance subcommands",
+	}
+	feegrantCmd.AddCommand(
+		client.GetCommands(
+			feegrantcmd.GetCmdQueryFeeGrants("feegrant", cdc),
+		)...)
+	feegrantCmd.AddCommand(
+		client.PostCommands(
+			feegrantcmd.GetCmdGrantFeeAllowance(cdc),
+			feegrantcmd.GetCmdRevokeFeeAllowance(cdc),
+		)...)
+	rootCmd.AddCommand(
+		feegrantCmd,
+	)
+
 	//Add auth and bank commands
 	rootCmd.AddCommand(
 		client.GetCommands(
 			authcmd.GetAccountCmd("acc", cdc, authcmd.GetAccountDecoder(cdc)),
+			authcmd.GetCmdQueryParams("auth", cdc),
+			bankcmd.GetCmdQueryBalances("bank", cdc),
+			bankcmd.GetCmdQuerySupply("bank", cdc),
+			bankcmd.GetCmdQueryDenoms("bank", cdc),
+			bankcmd.GetCmdQueryParams("bank", cdc),
 		)...)
 	rootCmd.AddCommand(
 		client.PostCommands(
 			bankcmd.SendTxCmd(cdc),
+			bankcmd.IssueTxCmd(cdc),
+			bankcmd.TransferOwnershipTxCmd(cdc),
 		)...)
 
 	// add proxy, version and key info
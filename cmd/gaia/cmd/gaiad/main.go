@@ -43,7 +43,10 @@ func main() {
 }
 
 func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application {
-	return app.NewGaiaApp(logger, db, traceStore, baseapp.SetPruning(viper.GetString("pruning")))
+	return app.NewGaiaApp(logger, db, traceStore,
+		baseapp.SetPruning(viper.GetString("pruning")),
+		baseapp.SetMinimumGasPrices(viper.GetString("minimum_gas_prices")),
+	)
 }
 
 func exportAppStateAndTMValidators(
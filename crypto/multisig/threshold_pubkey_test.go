@@ -0,0 +1,123 @@
+package multisig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+)
+
+// generate n private keys, their public keys and the signatures of msg
+func generatePubKeysAndSignatures(n int, msg []byte) (pubkeys []crypto.PubKey, signatures [][]byte) {
+	pubkeys = make([]crypto.PubKey, n)
+	signatures = make([][]byte, n)
+	for i := 0; i < n; i++ {
+		var privkey crypto.PrivKey
+		if i%2 == 0 {
+			privkey = ed25519.GenPrivKey()
+		} else {
+			privkey = secp256k1.GenPrivKey()
+		}
+		pubkeys[i] = privkey.PubKey()
+		signatures[i], _ = privkey.Sign(msg)
+	}
+	return
+}
+
+func TestThresholdMultisigValidCases(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(5, msg)
+
+	tests := []struct {
+		k              int
+		signingIndices []int
+		expValid       bool
+	}{
+		{2, []int{}, false},
+		{2, []int{4}, false},
+		{2, []int{0, 4}, true},
+		{2, []int{3, 1}, true},
+		{2, []int{0, 1, 2, 3, 4}, true},
+		{5, []int{0, 1, 2, 4}, false},
+		{5, []int{4, 2, 0, 3, 1}, true},
+	}
+	for i, tc := range tests {
+		multisigKey := NewPubKeyMultisigThreshold(tc.k, pubkeys)
+		multisignature := NewMultisig(len(pubkeys))
+		for _, j := range tc.signingIndices {
+			err := multisignature.AddSignatureFromPubKey(sigs[j], pubkeys[j], pubkeys)
+			require.Nil(t, err)
+		}
+		require.Equal(t, tc.expValid, multisigKey.VerifyBytes(msg, multisignature.Marshal()), "test: %v", i)
+	}
+}
+
+func TestThresholdMultisigInvalidCases(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, sigs := generatePubKeysAndSignatures(3, msg)
+	multisigKey := NewPubKeyMultisigThreshold(2, pubkeys)
+
+	// a signature of another message
+	multisignature := NewMultisig(len(pubkeys))
+	multisignature.AddSignature(sigs[0], 0)
+	_, otherSigs := generatePubKeysAndSignatures(3, []byte{5, 6, 7, 8})
+	multisignature.AddSignature(otherSigs[1], 1)
+	require.False(t, multisigKey.VerifyBytes(msg, multisignature.Marshal()))
+
+	// a signature at the index of another key
+	multisignature = NewMultisig(len(pubkeys))
+	multisignature.AddSignature(sigs[0], 0)
+	multisignature.AddSignature(sigs[1], 2)
+	require.False(t, multisigKey.VerifyBytes(msg, multisignature.Marshal()))
+
+	// a bit array of the wrong size
+	multisignature = NewMultisig(len(pubkeys) + 1)
+	multisignature.AddSignature(sigs[0], 0)
+	multisignature.AddSignature(sigs[1], 1)
+	require.False(t, multisigKey.VerifyBytes(msg, multisignature.Marshal()))
+
+	// more signatures than set bits
+	multisignature = NewMultisig(len(pubkeys))
+	multisignature.AddSignature(sigs[0], 0)
+	multisignature.AddSignature(sigs[1], 1)
+	multisignature.Sigs = append(multisignature.Sigs, sigs[2])
+	require.False(t, multisigKey.VerifyBytes(msg, multisignature.Marshal()))
+
+	// a malformed multisignature
+	require.False(t, multisigKey.VerifyBytes(msg, []byte("not a multisignature")))
+}
+
+func TestMultiSigPubKeyEquality(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, _ := generatePubKeysAndSignatures(5, msg)
+	multisigKey := NewPubKeyMultisigThreshold(2, pubkeys)
+
+	var unmarshalledMultisig PubKeyMultisigThreshold
+	cdc.MustUnmarshalBinaryBare(multisigKey.Bytes(), &unmarshalledMultisig)
+	require.True(t, multisigKey.Equals(unmarshalledMultisig))
+
+	// a different threshold or key order is a different key
+	require.False(t, multisigKey.Equals(NewPubKeyMultisigThreshold(3, pubkeys)))
+	pubkeysCpy := make([]crypto.PubKey, 5)
+	copy(pubkeysCpy, pubkeys)
+	pubkeysCpy[4], pubkeysCpy[3] = pubkeys[3], pubkeys[4]
+	otherMultisigKey := NewPubKeyMultisigThreshold(2, pubkeysCpy)
+	require.False(t, multisigKey.Equals(otherMultisigKey))
+	require.NotEqual(t, multisigKey.Address(), otherMultisigKey.Address())
+}
+
+func TestMultiSigPubKeyAminoJSON(t *testing.T) {
+	msg := []byte{1, 2, 3, 4}
+	pubkeys, _ := generatePubKeysAndSignatures(3, msg)
+	var multisigKey crypto.PubKey = NewPubKeyMultisigThreshold(2, pubkeys)
+
+	bz, err := cdc.MarshalJSON(multisigKey)
+	require.Nil(t, err)
+
+	var unmarshalled crypto.PubKey
+	err = cdc.UnmarshalJSON(bz, &unmarshalled)
+	require.Nil(t, err)
+	require.True(t, multisigKey.Equals(unmarshalled))
+}
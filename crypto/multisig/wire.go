@@ -0,0 +1,22 @@
+package multisig
+
+import (
+	"github.com/tendermint/go-amino"
+	"github.com/tendermint/tendermint/crypto/encoding/amino"
+)
+
+// amino route of the threshold multisig public key
+const PubKeyMultisigThresholdAminoRoute = "tendermint/PubKeyMultisigThreshold"
+
+var cdc = amino.NewCodec()
+
+func init() {
+	cryptoAmino.RegisterAmino(cdc)
+	RegisterAmino(cdc)
+}
+
+// RegisterAmino registers the multisig public key in the given (amino) codec.
+func RegisterAmino(cdc *amino.Codec) {
+	cdc.RegisterConcrete(PubKeyMultisigThreshold{},
+		PubKeyMultisigThresholdAminoRoute, nil)
+}
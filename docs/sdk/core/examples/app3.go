@@ -27,12 +27,11 @@ func NewApp3(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 
 	// Create a key for accessing the account store.
 	keyAccount := sdk.NewKVStoreKey("acc")
-	keyFees := sdk.NewKVStoreKey("fee") // TODO
 
 	// Set various mappers/keepers to interact easily with underlying stores
 	accountMapper := auth.NewAccountMapper(cdc, keyAccount, auth.ProtoBaseAccount)
 	coinKeeper := bank.NewKeeper(accountMapper)
-	feeKeeper := auth.NewFeeCollectionKeeper(cdc, keyFees)
+	feeKeeper := auth.NewFeeCollectionKeeper(accountMapper)
 
 	app.SetAnteHandler(auth.NewAnteHandler(accountMapper, feeKeeper))
 
@@ -42,7 +41,7 @@ func NewApp3(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 		AddRoute("bank", bank.NewHandler(coinKeeper))
 
 	// Mount stores and load the latest state.
-	app.MountStoresIAVL(keyAccount, keyFees)
+	app.MountStoresIAVL(keyAccount)
 	err := app.LoadLatestVersion(keyAccount)
 	if err != nil {
 		cmn.Exit(err.Error())
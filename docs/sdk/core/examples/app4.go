@@ -31,9 +31,7 @@ func NewApp4(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 	accountMapper := auth.NewAccountMapper(cdc, keyAccount, auth.ProtoBaseAccount)
 	coinKeeper := bank.NewKeeper(accountMapper)
 
-	// TODO
-	keyFees := sdk.NewKVStoreKey("fee")
-	feeKeeper := auth.NewFeeCollectionKeeper(cdc, keyFees)
+	feeKeeper := auth.NewFeeCollectionKeeper(accountMapper)
 
 	app.SetAnteHandler(auth.NewAnteHandler(accountMapper, feeKeeper))
 
@@ -46,7 +44,7 @@ func NewApp4(logger log.Logger, db dbm.DB) *bapp.BaseApp {
 		AddRoute("bank", bank.NewHandler(coinKeeper))
 
 	// Mount stores and load the latest state.
-	app.MountStoresIAVL(keyAccount, keyFees)
+	app.MountStoresIAVL(keyAccount)
 	err := app.LoadLatestVersion(keyAccount)
 	if err != nil {
 		cmn.Exit(err.Error())
@@ -68,7 +68,7 @@ func TestBadMsg(t *testing.T) {
 		Gas:    1000000000000000,
 		Amount: sdk.Coins{{"testCoin", sdk.NewInt(0)}},
 	}
-	signBytes := auth.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, "")
+	signBytes := auth.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, "", 0)
 	sig, err := priv1.Sign(signBytes)
 	if err != nil {
 		panic(err)
@@ -114,7 +114,7 @@ func TestMsgSend(t *testing.T) {
 		Gas:    1000000000000000,
 		Amount: sdk.Coins{{"testCoin", sdk.NewInt(0)}},
 	}
-	signBytes := auth.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, "")
+	signBytes := auth.StdSignBytes("test-chain", 0, 0, fee, []sdk.Msg{msg}, "", 0)
 	sig, err := priv1.Sign(signBytes)
 	if err != nil {
 		panic(err)
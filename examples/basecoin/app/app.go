@@ -75,6 +75,10 @@ func NewBasecoinApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		AddRoute("bank", bank.NewHandler(app.coinKeeper)).
 		AddRoute("ibc", ibc.NewHandler(app.ibcMapper, app.coinKeeper))
 
+	// the bank querier serves the denoms the cli parses amounts with
+	app.QueryRouter().
+		AddRoute("bank", bank.NewQuerier(app.coinKeeper))
+
 	// perform initialization logic
 	app.SetInitChainer(app.initChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -75,6 +75,7 @@ func NewDemocoinApp(logger log.Logger, db dbm.DB) *DemocoinApp {
 	)
 
 	// Add handlers.
+	app.feeCollectionKeeper = auth.NewFeeCollectionKeeper(app.accountMapper)
 	app.coinKeeper = bank.NewKeeper(app.accountMapper)
 	app.coolKeeper = cool.NewKeeper(app.capKeyMainStore, app.coinKeeper, app.RegisterCodespace(cool.DefaultCodespace))
 	app.powKeeper = pow.NewKeeper(app.capKeyPowStore, pow.NewConfig("pow", int64(1)), app.coinKeeper, app.RegisterCodespace(pow.DefaultCodespace))
@@ -88,6 +89,10 @@ func NewDemocoinApp(logger log.Logger, db dbm.DB) *DemocoinApp {
 		AddRoute("ibc", ibc.NewHandler(app.ibcMapper, app.coinKeeper)).
 		AddRoute("simplestake", simplestake.NewHandler(app.stakeKeeper))
 
+	// the bank querier serves the denoms the cli parses amounts with
+	app.QueryRouter().
+		AddRoute("bank", bank.NewQuerier(app.coinKeeper))
+
 	// Initialize BaseApp.
 	app.SetInitChainer(app.initChainerFn(app.coolKeeper, app.powKeeper))
 	app.MountStoresIAVL(app.capKeyMainStore, app.capKeyAccountStore, app.capKeyPowStore, app.capKeyIBCStore, app.capKeyStakingStore)
@@ -116,6 +121,7 @@ func MakeCodec() *wire.Codec {
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&types.AppAccount{}, "democoin/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "democoin/ModuleAccount", nil)
 
 	cdc.Seal()
 
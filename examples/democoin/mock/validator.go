@@ -58,6 +58,11 @@ func (v Validator) GetMoniker() string {
 	return ""
 }
 
+// Implements sdk.Validator
+func (v Validator) GetCommission() sdk.Dec {
+	return sdk.ZeroDec()
+}
+
 // Implements sdk.Validator
 type ValidatorSet struct {
 	Validators []Validator
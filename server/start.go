@@ -19,6 +19,7 @@ const (
 	flagAddress        = "address"
 	flagTraceStore     = "trace-store"
 	flagPruning        = "pruning"
+	flagMinGasPrices   = "minimum_gas_prices"
 )
 
 // StartCmd runs the service passed in, either stand-alone or in-process with
@@ -45,6 +46,8 @@ func StartCmd(ctx *Context, appCreator AppCreator) *cobra.Command {
 	cmd.Flags().String(flagAddress, "tcp://0.0.0.0:26658", "Listen address")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(flagPruning, "syncable", "Pruning strategy: syncable, nothing, everything")
+	cmd.Flags().String(flagMinGasPrices, "",
+		"Minimum gas prices to accept for transactions in the mempool, any of which satisfies the fee (e.g. 0.01photino,0.0001steak)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -44,6 +44,8 @@ func NewContext(ms MultiStore, header abci.Header, isCheckTx bool, logger log.Lo
 	c = c.WithLogger(logger)
 	c = c.WithSigningValidators(nil)
 	c = c.WithGasMeter(NewInfiniteGasMeter())
+	c = c.WithIsCheckTx(isCheckTx)
+	c = c.WithMinimumGasPrices(DecCoins{})
 	return c
 }
 
@@ -135,6 +137,8 @@ const (
 	contextKeyLogger
 	contextKeySigningValidators
 	contextKeyGasMeter
+	contextKeyIsCheckTx
+	contextKeyMinimumGasPrices
 )
 
 // NOTE: Do not expose MultiStore.
@@ -169,6 +173,12 @@ func (c Context) SigningValidators() []abci.SigningValidator {
 func (c Context) GasMeter() GasMeter {
 	return c.Value(contextKeyGasMeter).(GasMeter)
 }
+func (c Context) IsCheckTx() bool {
+	return c.Value(contextKeyIsCheckTx).(bool)
+}
+func (c Context) MinimumGasPrices() DecCoins {
+	return c.Value(contextKeyMinimumGasPrices).(DecCoins)
+}
 func (c Context) WithMultiStore(ms MultiStore) Context {
 	return c.withValue(contextKeyMultiStore, ms)
 }
@@ -201,6 +211,12 @@ func (c Context) WithSigningValidators(SigningValidators []abci.SigningValidator
 func (c Context) WithGasMeter(meter GasMeter) Context {
 	return c.withValue(contextKeyGasMeter, meter)
 }
+func (c Context) WithIsCheckTx(isCheckTx bool) Context {
+	return c.withValue(contextKeyIsCheckTx, isCheckTx)
+}
+func (c Context) WithMinimumGasPrices(gasPrices DecCoins) Context {
+	return c.withValue(contextKeyMinimumGasPrices, gasPrices)
+}
 
 // Cache the multistore and return a new cached context. The cached context is
 // written to the context when writeCache is called.
@@ -0,0 +1,288 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Coins which can have additional decimal points
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount Dec    `json:"amount"`
+}
+
+func NewDecCoin(denom string, amount int64) DecCoin {
+	return DecCoin{
+		Denom:  denom,
+		Amount: NewDec(amount),
+	}
+}
+
+func NewDecCoinFromDec(denom string, amount Dec) DecCoin {
+	return DecCoin{
+		Denom:  denom,
+		Amount: amount,
+	}
+}
+
+func NewDecCoinFromCoin(coin Coin) DecCoin {
+	return DecCoin{
+		Denom:  coin.Denom,
+		Amount: NewDecFromInt(coin.Amount),
+	}
+}
+
+// String provides a human-readable representation of a coin
+func (coin DecCoin) String() string {
+	return fmt.Sprintf("%v%v", coin.Amount, coin.Denom)
+}
+
+// Adds amounts of two coins with same denom
+func (coin DecCoin) Plus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Add(coinB.Amount)}
+}
+
+// Subtracts amounts of two coins with same denom
+func (coin DecCoin) Minus(coinB DecCoin) DecCoin {
+	if coin.Denom != coinB.Denom {
+		panic(fmt.Sprintf("coin denom different: %v %v\n", coin.Denom, coinB.Denom))
+	}
+	return DecCoin{coin.Denom, coin.Amount.Sub(coinB.Amount)}
+}
+
+// return the decimal coin truncated to a regular coin, as well as the
+// remaining change
+func (coin DecCoin) TruncateDecimal() (Coin, DecCoin) {
+	truncated := coin.Amount.TruncateInt()
+	change := coin.Amount.Sub(NewDecFromInt(truncated))
+	return NewCoin(coin.Denom, truncated), DecCoin{coin.Denom, change}
+}
+
+//_______________________________________________________________________
+
+// coins with decimal
+type DecCoins []DecCoin
+
+func NewDecCoins(coins Coins) DecCoins {
+	dcs := make(DecCoins, len(coins))
+	for i, coin := range coins {
+		dcs[i] = NewDecCoinFromCoin(coin)
+	}
+	return dcs
+}
+
+func (coins DecCoins) String() string {
+	if len(coins) == 0 {
+		return ""
+	}
+
+	out := ""
+	for _, coin := range coins {
+		out += fmt.Sprintf("%v,", coin.String())
+	}
+	return out[:len(out)-1]
+}
+
+// return the coins with truncated decimals, and the accumulated change,
+// coins which truncate to zero are left out of the returned coins
+func (coins DecCoins) TruncateDecimal() (Coins, DecCoins) {
+	var out Coins
+	var change DecCoins
+	for _, coin := range coins {
+		truncated, chg := coin.TruncateDecimal()
+		if !truncated.IsZero() {
+			out = append(out, truncated)
+		}
+		if !chg.Amount.IsZero() {
+			change = append(change, chg)
+		}
+	}
+	return out, change
+}
+
+// Plus combines two sets of coins
+// CONTRACT: Plus will never return Coins where one Coin has a 0 amount.
+func (coins DecCoins) Plus(coinsB DecCoins) DecCoins {
+	sum := ([]DecCoin)(nil)
+	indexA, indexB := 0, 0
+	lenA, lenB := len(coins), len(coinsB)
+	for {
+		if indexA == lenA {
+			if indexB == lenB {
+				return sum
+			}
+			return append(sum, coinsB[indexB:]...)
+		} else if indexB == lenB {
+			return append(sum, coins[indexA:]...)
+		}
+		coinA, coinB := coins[indexA], coinsB[indexB]
+		switch strings.Compare(coinA.Denom, coinB.Denom) {
+		case -1:
+			sum = append(sum, coinA)
+			indexA++
+		case 0:
+			if coinA.Amount.Add(coinB.Amount).IsZero() {
+				// ignore 0 sum coin type
+			} else {
+				sum = append(sum, coinA.Plus(coinB))
+			}
+			indexA++
+			indexB++
+		case 1:
+			sum = append(sum, coinB)
+			indexB++
+		}
+	}
+}
+
+// Negative returns a set of coins with all amount negative
+func (coins DecCoins) Negative() DecCoins {
+	res := make([]DecCoin, 0, len(coins))
+	for _, coin := range coins {
+		res = append(res, DecCoin{
+			Denom:  coin.Denom,
+			Amount: coin.Amount.Neg(),
+		})
+	}
+	return res
+}
+
+// Minus subtracts a set of coins from another (adds the inverse)
+func (coins DecCoins) Minus(coinsB DecCoins) DecCoins {
+	return coins.Plus(coinsB.Negative())
+}
+
+// multiply all the coins by a decimal, dropping any coins which become zero
+func (coins DecCoins) MulDec(d Dec) DecCoins {
+	var res DecCoins
+	for _, coin := range coins {
+		product := coin.Amount.Mul(d)
+		if !product.IsZero() {
+			res = append(res, DecCoin{coin.Denom, product})
+		}
+	}
+	return res
+}
+
+// divide all the coins by a decimal, dropping any coins which become zero
+func (coins DecCoins) QuoDec(d Dec) DecCoins {
+	var res DecCoins
+	for _, coin := range coins {
+		quotient := coin.Amount.Quo(d)
+		if !quotient.IsZero() {
+			res = append(res, DecCoin{coin.Denom, quotient})
+		}
+	}
+	return res
+}
+
+// returns the amount of a denom from deccoins
+func (coins DecCoins) AmountOf(denom string) Dec {
+	for _, coin := range coins {
+		if coin.Denom == denom {
+			return coin.Amount
+		}
+	}
+	return ZeroDec()
+}
+
+// has no coins or all coins are zero
+func (coins DecCoins) IsZero() bool {
+	for _, coin := range coins {
+		if !coin.Amount.IsZero() {
+			return false
+		}
+	}
+	return true
+}
+
+// has any coin with a negative amount
+func (coins DecCoins) HasNegative() bool {
+	for _, coin := range coins {
+		if coin.Amount.LT(ZeroDec()) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsEqual returns true if the two sets of DecCoins have the same value
+func (coins DecCoins) IsEqual(coinsB DecCoins) bool {
+	if len(coins) != len(coinsB) {
+		return false
+	}
+	for i := 0; i < len(coins); i++ {
+		if coins[i].Denom != coinsB[i].Denom || !coins[i].Amount.Equal(coinsB[i].Amount) {
+			return false
+		}
+	}
+	return true
+}
+
+// Sort the coins by denomination
+func (coins DecCoins) Sort() DecCoins {
+	sort.Slice(coins, func(i, j int) bool { return coins[i].Denom < coins[j].Denom })
+	return coins
+}
+
+//_______________________________________________________________________
+// Parsing
+
+var (
+	reDecAmt  = `[[:digit:]]*\.?[[:digit:]]+`
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnm))
+)
+
+// ParseDecCoin parses a decimal coin from a string, e.g. "0.025steak".
+func ParseDecCoin(coinStr string) (coin DecCoin, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDecCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		return coin, fmt.Errorf("invalid decimal coin expression: %s", coinStr)
+	}
+	denomStr, amountStr := matches[2], matches[1]
+
+	amount, err := NewDecFromStr(amountStr)
+	if err != nil {
+		return coin, fmt.Errorf("invalid decimal coin amount %s: %v", amountStr, err)
+	}
+
+	return DecCoin{denomStr, amount}, nil
+}
+
+// ParseDecCoins parses a list of decimal coins separated by commas.
+// If nothing is provided, it returns nil DecCoins.
+// Returned coins are sorted.
+func ParseDecCoins(coinsStr string) (coins DecCoins, err error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	coinStrs := strings.Split(coinsStr, ",")
+	for _, coinStr := range coinStrs {
+		coin, err := ParseDecCoin(coinStr)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	// Sort coins for determinism.
+	coins.Sort()
+
+	// Denominations must be unique.
+	for i := 1; i < len(coins); i++ {
+		if coins[i-1].Denom == coins[i].Denom {
+			return nil, fmt.Errorf("duplicate decimal coin denomination %s", coins[i].Denom)
+		}
+	}
+
+	return coins, nil
+}
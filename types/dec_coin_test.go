@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecCoinsPlusMinus(t *testing.T) {
+	a := DecCoins{NewDecCoin("atom", 1), NewDecCoin("steak", 2)}
+	b := DecCoins{NewDecCoin("btc", 3), NewDecCoin("steak", 4)}
+
+	sum := a.Plus(b)
+	require.True(t, DecCoins{NewDecCoin("atom", 1), NewDecCoin("btc", 3), NewDecCoin("steak", 6)}.IsEqual(sum))
+	require.True(t, a.IsEqual(sum.Minus(b)))
+
+	// zero sums are removed
+	require.True(t, a.Minus(a).IsZero())
+	require.Equal(t, 0, len(a.Minus(a)))
+}
+
+func TestDecCoinsMulQuo(t *testing.T) {
+	coins := DecCoins{NewDecCoin("atom", 10), NewDecCoin("steak", 4)}
+
+	half := NewDecWithPrec(5, 1)
+	require.True(t, DecCoins{NewDecCoin("atom", 5), NewDecCoin("steak", 2)}.IsEqual(coins.MulDec(half)))
+	require.True(t, DecCoins{NewDecCoin("atom", 5), NewDecCoin("steak", 2)}.IsEqual(coins.QuoDec(NewDec(2))))
+	require.Equal(t, 0, len(coins.MulDec(ZeroDec())))
+}
+
+func TestDecCoinsTruncateDecimal(t *testing.T) {
+	coins := DecCoins{
+		NewDecCoinFromDec("atom", NewDecWithPrec(15, 1)),
+		NewDecCoinFromDec("btc", NewDecWithPrec(5, 1)),
+		NewDecCoin("steak", 3),
+	}
+
+	truncated, change := coins.TruncateDecimal()
+	require.True(t, Coins{NewInt64Coin("atom", 1), NewInt64Coin("steak", 3)}.IsEqual(truncated))
+	expChange := DecCoins{
+		NewDecCoinFromDec("atom", NewDecWithPrec(5, 1)),
+		NewDecCoinFromDec("btc", NewDecWithPrec(5, 1)),
+	}
+	require.True(t, expChange.IsEqual(change))
+
+	// round trip of whole coins
+	whole := Coins{NewInt64Coin("atom", 7), NewInt64Coin("steak", 9)}
+	truncated, change = NewDecCoins(whole).TruncateDecimal()
+	require.True(t, whole.IsEqual(truncated))
+	require.Equal(t, 0, len(change))
+}
+
+func TestParseDecCoins(t *testing.T) {
+	cases := []struct {
+		input    string
+		valid    bool     // if false, we expect an error on parse
+		expected DecCoins // if valid is true, make sure this is returned
+	}{
+		{"", true, nil},
+		{"1foo", true, DecCoins{{"foo", NewDec(1)}}},
+		{"0.025steak", true, DecCoins{{"steak", NewDecWithPrec(25, 3)}}},
+		{"0.5foo, 10.1 bar", true, DecCoins{{"bar", NewDecWithPrec(101, 1)}, {"foo", NewDecWithPrec(5, 1)}}},
+		{"0.5foo,", false, nil},     // no empty coins in a list
+		{"1foo,2foo", false, nil},   // no duplicate denominations
+		{"1.foo", false, nil},       // decimal point must be followed by digits
+		{"0.5foo-bar", false, nil},  // only letters in coin name
+		{"0.5 my coin", false, nil}, // no spaces in coin names
+		{"-0.5foo", false, nil},     // no negative amounts
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := ParseDecCoins(tc.input)
+		if !tc.valid {
+			require.NotNil(t, err, "%s: %#v. tc #%d", tc.input, res, tcIndex)
+			continue
+		}
+		require.Nil(t, err, "%s: %+v", tc.input, err)
+		require.True(t, tc.expected.IsEqual(res), "dec coin parsing was incorrect, tc #%d: %v", tcIndex, res)
+	}
+}
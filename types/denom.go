@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxDenomExponent is the largest exponent of a denom unit, which bounds the
+// digits added to the amounts converted to the base denom
+const MaxDenomExponent uint32 = 18
+
+// DenomUnit is a unit of a denom, worth 10^Exponent of its base units
+type DenomUnit struct {
+	Denom    string `json:"denom"`
+	Exponent uint32 `json:"exponent"`
+}
+
+// NewDenomUnit returns a new DenomUnit
+func NewDenomUnit(denom string, exponent uint32) DenomUnit {
+	return DenomUnit{
+		Denom:    denom,
+		Exponent: exponent,
+	}
+}
+
+// DenomMetadata describes the units of a base denom, the denom coins are
+// counted in, and the unit they are displayed in
+type DenomMetadata struct {
+	Base        string      `json:"base"`
+	Display     string      `json:"display"`
+	DenomUnits  []DenomUnit `json:"denom_units"`
+	Description string      `json:"description"`
+}
+
+// NewDenomMetadata returns a new DenomMetadata
+func NewDenomMetadata(base, display string, units []DenomUnit, description string) DenomMetadata {
+	return DenomMetadata{
+		Base:        base,
+		Display:     display,
+		DenomUnits:  units,
+		Description: description,
+	}
+}
+
+var reDenom = regexp.MustCompile(fmt.Sprintf(`^%s$`, reDnm))
+
+// Validate checks that the base denom is a unit with exponent zero, that the
+// exponents do not exceed MaxDenomExponent and that the display denom is one
+// of the units
+func (m DenomMetadata) Validate() error {
+	if !reDenom.MatchString(m.Base) {
+		return fmt.Errorf("invalid base denom: %s", m.Base)
+	}
+
+	seen := make(map[string]bool)
+	for _, unit := range m.DenomUnits {
+		if !reDenom.MatchString(unit.Denom) {
+			return fmt.Errorf("invalid unit denom of %s: %s", m.Base, unit.Denom)
+		}
+		if seen[unit.Denom] {
+			return fmt.Errorf("duplicate unit denom of %s: %s", m.Base, unit.Denom)
+		}
+		if unit.Denom == m.Base && unit.Exponent != 0 {
+			return fmt.Errorf("the base denom %s must have exponent 0", m.Base)
+		}
+		if unit.Exponent > MaxDenomExponent {
+			return fmt.Errorf("the exponent %d of %s exceeds %d", unit.Exponent, unit.Denom, MaxDenomExponent)
+		}
+		seen[unit.Denom] = true
+	}
+	if !seen[m.Base] {
+		return fmt.Errorf("the base denom %s is not one of its units", m.Base)
+	}
+	if !seen[m.Display] {
+		return fmt.Errorf("the display denom %s of %s is not one of its units", m.Display, m.Base)
+	}
+	return nil
+}
+
+// the exponent of a unit of the denom
+func (m DenomMetadata) exponent(denom string) (uint32, bool) {
+	for _, unit := range m.DenomUnits {
+		if unit.Denom == denom {
+			return unit.Exponent, true
+		}
+	}
+	return 0, false
+}
+
+//----------------------------------------
+// Parsing
+
+var (
+	reDecAmt  = `[[:digit:]]+(?:\.[[:digit:]]+)?`
+	reDecCoin = regexp.MustCompile(fmt.Sprintf(`^(%s)%s(%s)$`, reDecAmt, reSpc, reDnm))
+)
+
+// ParseCoinWithMetadata parses a cli input for one coin type like ParseCoin,
+// also accepting decimal amounts in a unit of the denoms described by the
+// metadata, like "1.5atom", which are converted to the base denom.
+func ParseCoinWithMetadata(coinStr string, metadata []DenomMetadata) (coin Coin, err error) {
+	coinStr = strings.TrimSpace(coinStr)
+
+	matches := reDecCoin.FindStringSubmatch(coinStr)
+	if matches == nil {
+		err = fmt.Errorf("invalid coin expression: %s", coinStr)
+		return
+	}
+	denomStr, amountStr := matches[2], matches[1]
+
+	base, exponent := denomStr, uint32(0)
+	for _, m := range metadata {
+		if exp, ok := m.exponent(denomStr); ok {
+			base, exponent = m.Base, exp
+			break
+		}
+	}
+
+	amount, err := scaleAmount(amountStr, exponent)
+	if err != nil {
+		return
+	}
+
+	return Coin{base, amount}, nil
+}
+
+// ParseCoinsWithMetadata parses a list of coins separated by commas like
+// ParseCoins, converting the amounts in a unit of the denoms described by the
+// metadata to their base denom.
+func ParseCoinsWithMetadata(coinsStr string, metadata []DenomMetadata) (coins Coins, err error) {
+	coinsStr = strings.TrimSpace(coinsStr)
+	if len(coinsStr) == 0 {
+		return nil, nil
+	}
+
+	coinStrs := strings.Split(coinsStr, ",")
+	for _, coinStr := range coinStrs {
+		coin, err := ParseCoinWithMetadata(coinStr, metadata)
+		if err != nil {
+			return nil, err
+		}
+		coins = append(coins, coin)
+	}
+
+	// Sort coins for determinism.
+	coins.Sort()
+
+	// Validate coins before returning.
+	if !coins.IsValid() {
+		return nil, fmt.Errorf("parseCoins invalid: %#v", coins)
+	}
+
+	return coins, nil
+}
+
+// multiply a decimal amount by 10^exponent, which must make it an integer
+func scaleAmount(amountStr string, exponent uint32) (Int, error) {
+	if exponent > MaxDenomExponent {
+		return Int{}, fmt.Errorf("exponent %d exceeds %d", exponent, MaxDenomExponent)
+	}
+	parts := strings.SplitN(amountStr, ".", 2)
+	intPart, fracPart := parts[0], ""
+	if len(parts) == 2 {
+		fracPart = strings.TrimRight(parts[1], "0")
+	}
+	if len(fracPart) > int(exponent) {
+		return Int{}, fmt.Errorf("amount %s has more than %d decimal places", amountStr, exponent)
+	}
+
+	// trim the leading zeros, which would make the digits octal
+	digits := strings.TrimLeft(intPart+fracPart, "0")
+	if len(digits) == 0 {
+		return ZeroInt(), nil
+	}
+	digits += strings.Repeat("0", int(exponent)-len(fracPart))
+	amount, ok := NewIntFromString(digits)
+	if !ok {
+		return Int{}, fmt.Errorf("invalid amount: %s", amountStr)
+	}
+	return amount, nil
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+var atomMetadata = NewDenomMetadata("uatom", "atom",
+	[]DenomUnit{NewDenomUnit("uatom", 0), NewDenomUnit("matom", 3), NewDenomUnit("atom", 6)},
+	"The native staking token")
+
+func TestDenomMetadataValidate(t *testing.T) {
+	require.Nil(t, atomMetadata.Validate())
+
+	cases := []DenomMetadata{
+		NewDenomMetadata("u", "u", []DenomUnit{NewDenomUnit("u", 0)}, ""),                                       // invalid base
+		NewDenomMetadata("uatom", "atom", []DenomUnit{NewDenomUnit("atom", 6)}, ""),                             // base is not a unit
+		NewDenomMetadata("uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 1), NewDenomUnit("atom", 6)}, ""),   // base exponent is not zero
+		NewDenomMetadata("uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0)}, ""),                            // display is not a unit
+		NewDenomMetadata("uatom", "uatom", []DenomUnit{NewDenomUnit("uatom", 0), NewDenomUnit("uatom", 0)}, ""), // duplicate unit
+		NewDenomMetadata("uatom", "atom", []DenomUnit{NewDenomUnit("uatom", 0), NewDenomUnit("atom", 19)}, ""),  // exponent too large
+	}
+	for i, m := range cases {
+		require.NotNil(t, m.Validate(), "tc #%d", i)
+	}
+}
+
+func TestParseCoinsWithMetadata(t *testing.T) {
+	metadata := []DenomMetadata{atomMetadata}
+
+	cases := []struct {
+		input    string
+		valid    bool  // if false, we expect an error on parse
+		expected Coins // if valid is true, make sure this is returned
+	}{
+		{"", true, nil},
+		{"1.5atom", true, Coins{NewInt64Coin("uatom", 1500000)}},
+		{"0.015atom", true, Coins{NewInt64Coin("uatom", 15000)}},
+		{"2matom, 10foo", true, Coins{NewInt64Coin("foo", 10), NewInt64Coin("uatom", 2000)}},
+		{"7uatom", true, Coins{NewInt64Coin("uatom", 7)}},
+		{"1.50000000atom", true, Coins{NewInt64Coin("uatom", 1500000)}},
+		{"0.0000001atom", false, nil}, // less than a base unit
+		{"1.5uatom", false, nil},      // base units are integers
+		{"1.5foo", false, nil},        // unknown denoms are integers
+		{"1atom,1uatom", false, nil},  // both convert to uatom
+		{"0atom", false, nil},         // no zero coins
+	}
+
+	for tcIndex, tc := range cases {
+		res, err := ParseCoinsWithMetadata(tc.input, metadata)
+		if !tc.valid {
+			require.NotNil(t, err, "%s: %#v. tc #%d", tc.input, res, tcIndex)
+		} else {
+			require.Nil(t, err, "%s: %+v", tc.input, err)
+			require.Equal(t, tc.expected, res, "coin parsing was incorrect, tc #%d", tcIndex)
+		}
+	}
+
+	// units with an exponent above the max are not scaled
+	huge := NewDenomMetadata("uhuge", "huge", []DenomUnit{NewDenomUnit("uhuge", 0), NewDenomUnit("huge", 1<<31)}, "")
+	_, err := ParseCoinsWithMetadata("1huge", []DenomMetadata{huge})
+	require.NotNil(t, err)
+}
@@ -56,6 +56,8 @@ const (
 	CodeInvalidCoins      CodeType = 11
 	CodeOutOfGas          CodeType = 12
 	CodeMemoTooLarge      CodeType = 13
+	CodeInsufficientFee   CodeType = 14
+	CodeTxTimeoutHeight   CodeType = 15
 
 	// CodespaceRoot is a codespace for error codes in this file only.
 	// Notice that 0 is an "unset" codespace, which can be overridden with
@@ -101,6 +103,10 @@ func CodeToDefaultMsg(code CodeType) string {
 		return "out of gas"
 	case CodeMemoTooLarge:
 		return "memo too large"
+	case CodeInsufficientFee:
+		return "insufficient fee"
+	case CodeTxTimeoutHeight:
+		return "tx timeout height"
 	default:
 		return unknownCodeMsg(code)
 	}
@@ -150,6 +156,12 @@ func ErrOutOfGas(msg string) Error {
 func ErrMemoTooLarge(msg string) Error {
 	return newErrorWithRootCodespace(CodeMemoTooLarge, msg)
 }
+func ErrInsufficientFee(msg string) Error {
+	return newErrorWithRootCodespace(CodeInsufficientFee, msg)
+}
+func ErrTxTimeoutHeight(msg string) Error {
+	return newErrorWithRootCodespace(CodeTxTimeoutHeight, msg)
+}
 
 //----------------------------------------
 // Error & sdkError
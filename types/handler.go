@@ -6,3 +6,7 @@ type Handler func(ctx Context, msg Msg) Result
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Context, tx Tx, simulate bool) (newCtx Context, result Result, abort bool)
+
+// FeeRefundHandler refunds part of the fees paid for the gas a tx wanted but
+// did not use, once its messages have been handled. It returns the refund.
+type FeeRefundHandler func(ctx Context, tx Tx, result Result) (refund Coins)
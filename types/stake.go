@@ -46,6 +46,7 @@ type Validator interface {
 	GetTokens() Dec           // validation tokens
 	GetDelegatorShares() Dec  // Total out standing delegator shares
 	GetBondHeight() int64     // height in which the validator became active
+	GetCommission() Dec       // commission rate charged to delegators
 }
 
 // validator which fulfills abci validator interface for use in Tendermint
@@ -95,3 +96,14 @@ type DelegationSet interface {
 	IterateDelegations(ctx Context, delegator AccAddress,
 		fn func(index int64, delegation Delegation) (stop bool))
 }
+
+//_______________________________________________________________________________
+
+// event hooks for staking validator and delegation objects
+type StakingHooks interface {
+	OnValidatorRemoved(ctx Context, address AccAddress) // Must be called when a validator is deleted
+
+	OnDelegationCreated(ctx Context, delAddr, valAddr AccAddress)            // Must be called when a delegation is created
+	BeforeDelegationSharesModified(ctx Context, delAddr, valAddr AccAddress) // Must be called before a delegation's shares are modified
+	OnDelegationRemoved(ctx Context, delAddr, valAddr AccAddress)            // Must be called when a delegation is removed
+}
@@ -6,6 +6,8 @@ import (
 
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/crypto/encoding/amino"
+
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 )
 
 // amino codec to marshal/unmarshal
@@ -19,6 +21,7 @@ func NewCodec() *Codec {
 // Register the go-crypto to the codec
 func RegisterCrypto(cdc *Codec) {
 	cryptoAmino.RegisterAmino(cdc)
+	multisig.RegisterAmino(cdc)
 }
 
 // attempt to make some pretty json
@@ -3,26 +3,49 @@ package auth
 import (
 	"bytes"
 	"fmt"
+	"time"
 
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtags "github.com/cosmos/cosmos-sdk/x/auth/tags"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/crypto/secp256k1"
 )
 
-const (
-	deductFeesCost      sdk.Gas = 10
-	memoCostPerByte     sdk.Gas = 1
-	ed25519VerifyCost           = 59
-	secp256k1VerifyCost         = 100
-	maxMemoCharacters           = 100
-)
+// FeeGrantKeeper charges the fees paid by a granter to the fee allowance it
+// granted to a grantee, and gives refunded fees back to the allowance
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error
+	RestoreGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins)
+}
 
 // NewAnteHandler returns an AnteHandler that checks
 // and increments sequence numbers, checks signatures & account numbers,
-// and deducts fees from the first signer.
-// nolint: gocyclo
+// and deducts fees from the first signer. It uses the default auth params.
 func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
+	return NewAnteHandlerWithFeeGrants(am, fck, nil)
+}
+
+// NewAnteHandlerWithFeeGrants returns an AnteHandler like NewAnteHandler
+// which also deducts the fees from a fee granter set in the tx fee, charging
+// them to the allowance granted to the first signer.
+func NewAnteHandlerWithFeeGrants(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper) sdk.AnteHandler {
+	return newAnteHandler(am, fck, fgk, func(_ sdk.Context) Params {
+		return DefaultParams()
+	})
+}
+
+// NewAnteHandlerWithParams returns an AnteHandler like
+// NewAnteHandlerWithFeeGrants which reads the memo limit and gas costs from
+// the auth params in the global param store.
+func NewAnteHandlerWithParams(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper, pk ParamsKeeper) sdk.AnteHandler {
+	return newAnteHandler(am, fck, fgk, pk.GetParams)
+}
+
+// nolint: gocyclo
+func newAnteHandler(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper,
+	getParams func(ctx sdk.Context) Params) sdk.AnteHandler {
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx, simulate bool,
@@ -60,17 +83,33 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			}
 		}()
 
-		err := validateBasic(stdTx)
+		params := getParams(newCtx)
+
+		err := validateBasic(stdTx, params)
 		if err != nil {
 			return newCtx, err.Result(), true
 		}
 
+		// reject txs included in a block above their timeout height
+		if stdTx.TimeoutHeight > 0 && newCtx.BlockHeight() > stdTx.TimeoutHeight {
+			errMsg := fmt.Sprintf("block height %d is above the tx timeout height %d", newCtx.BlockHeight(), stdTx.TimeoutHeight)
+			return newCtx, sdk.ErrTxTimeoutHeight(errMsg).Result(), true
+		}
+
+		// the node's minimum gas prices only apply to txs entering its mempool
+		if newCtx.IsCheckTx() && !simulate {
+			res := ensureSufficientMempoolFees(newCtx, stdTx.Fee)
+			if !res.IsOK() {
+				return newCtx, res, true
+			}
+		}
+
 		sigs := stdTx.GetSignatures()
 		signerAddrs := stdTx.GetSigners()
 		msgs := tx.GetMsgs()
 
 		// charge gas for the memo
-		newCtx.GasMeter().ConsumeGas(memoCostPerByte*sdk.Gas(len(stdTx.GetMemo())), "memo")
+		newCtx.GasMeter().ConsumeGas(params.MemoCostPerByte*sdk.Gas(len(stdTx.GetMemo())), "memo")
 
 		// Get the sign bytes (requires all account & sequence numbers and the fee)
 		sequences := make([]int64, len(sigs))
@@ -87,25 +126,28 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 			signerAddr, sig := signerAddrs[i], sigs[i]
 
 			// check signature, return account with incremented nonce
-			signBytes := StdSignBytes(newCtx.ChainID(), accNums[i], sequences[i], fee, msgs, stdTx.GetMemo())
+			signBytes := StdSignBytes(newCtx.ChainID(), accNums[i], sequences[i], fee, msgs, stdTx.GetMemo(), stdTx.TimeoutHeight)
 			signerAcc, res := processSig(
-				newCtx, am,
+				newCtx, am, params,
 				signerAddr, sig, signBytes,
 			)
 			if !res.IsOK() {
 				return newCtx, res, true
 			}
 
-			// first sig pays the fees
-			// TODO: Add min fees
+			// first sig pays the fees, unless they are paid by a fee granter
 			// Can this function be moved outside of the loop?
 			if i == 0 && !fee.Amount.IsZero() {
-				newCtx.GasMeter().ConsumeGas(deductFeesCost, "deductFees")
-				signerAcc, res = deductFees(signerAcc, fee)
+				newCtx.GasMeter().ConsumeGas(params.DeductFeesCost, "deductFees")
+				if len(fee.Granter) == 0 {
+					signerAcc, res = deductFees(signerAcc, fee, newCtx.BlockHeader().Time)
+				} else {
+					res = deductGrantedFees(newCtx, am, fgk, fee, signerAddr)
+				}
 				if !res.IsOK() {
 					return newCtx, res, true
 				}
-				fck.addCollectedFees(newCtx, fee.Amount)
+				fck.AddCollectedFees(newCtx, fee.Amount)
 			}
 
 			// Save the account.
@@ -116,14 +158,42 @@ func NewAnteHandler(am AccountMapper, fck FeeCollectionKeeper) sdk.AnteHandler {
 		// cache the signer accounts in the context
 		newCtx = WithSigners(newCtx, signerAccs)
 
-		// TODO: tx tags (?)
+		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas, Tags: txTags(stdTx)}, false // continue...
+	}
+}
 
-		return newCtx, sdk.Result{GasWanted: stdTx.Fee.Gas}, false // continue...
+// tag the tx with its signers, fee payer, fee and message types so that every
+// tx can be searched by them
+func txTags(tx StdTx) sdk.Tags {
+	tags := sdk.EmptyTags()
+	signers := tx.GetSigners()
+	for _, signer := range signers {
+		tags = tags.AppendTag(authtags.Signer, []byte(signer.String()))
+	}
+
+	fee := tx.Fee
+	if !fee.Amount.IsZero() {
+		payer := fee.Granter
+		if len(payer) == 0 {
+			payer = signers[0]
+		}
+		tags = tags.AppendTag(authtags.FeePayer, []byte(payer.String()))
+		tags = tags.AppendTag(authtags.Fee, []byte(fee.Amount.String()))
+	}
+
+	msgTypes := make(map[string]bool)
+	for _, msg := range tx.GetMsgs() {
+		if msgTypes[msg.Type()] {
+			continue
+		}
+		msgTypes[msg.Type()] = true
+		tags = tags.AppendTag(authtags.MsgType, []byte(msg.Type()))
 	}
+	return tags
 }
 
 // Validate the transaction based on things that don't depend on the context
-func validateBasic(tx StdTx) (err sdk.Error) {
+func validateBasic(tx StdTx, params Params) (err sdk.Error) {
 	// Assert that there are signatures.
 	sigs := tx.GetSignatures()
 	if len(sigs) == 0 {
@@ -137,10 +207,10 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 	}
 
 	memo := tx.GetMemo()
-	if len(memo) > maxMemoCharacters {
+	if int64(len(memo)) > params.MaxMemoCharacters {
 		return sdk.ErrMemoTooLarge(
 			fmt.Sprintf("maximum number of characters is %d but received %d characters",
-				maxMemoCharacters, len(memo)))
+				params.MaxMemoCharacters, len(memo)))
 	}
 	return nil
 }
@@ -148,7 +218,7 @@ func validateBasic(tx StdTx) (err sdk.Error) {
 // verify the signature and increment the sequence.
 // if the account doesn't have a pubkey, set it.
 func processSig(
-	ctx sdk.Context, am AccountMapper,
+	ctx sdk.Context, am AccountMapper, params Params,
 	addr sdk.AccAddress, sig StdSignature, signBytes []byte) (
 	acc Account, res sdk.Result) {
 
@@ -195,7 +265,7 @@ func processSig(
 	}
 
 	// Check sig.
-	consumeSignatureVerificationGas(ctx.GasMeter(), pubKey)
+	consumeSignatureVerificationGas(ctx.GasMeter(), params, sig.Signature, pubKey)
 	if !pubKey.VerifyBytes(signBytes, sig.Signature) {
 		return nil, sdk.ErrUnauthorized("signature verification failed").Result()
 	}
@@ -203,29 +273,55 @@ func processSig(
 	return
 }
 
-func consumeSignatureVerificationGas(meter sdk.GasMeter, pubkey crypto.PubKey) {
-	switch pubkey.(type) {
+// consume the gas of verifying a signature, a multisignature is charged for
+// the verification of each of its sub-signatures
+func consumeSignatureVerificationGas(meter sdk.GasMeter, params Params, sig []byte, pubkey crypto.PubKey) {
+	switch pubkey := pubkey.(type) {
 	case ed25519.PubKeyEd25519:
-		meter.ConsumeGas(ed25519VerifyCost, "ante verify: ed25519")
+		meter.ConsumeGas(params.Ed25519VerifyCost, "ante verify: ed25519")
 	case secp256k1.PubKeySecp256k1:
-		meter.ConsumeGas(secp256k1VerifyCost, "ante verify: secp256k1")
+		meter.ConsumeGas(params.Secp256k1VerifyCost, "ante verify: secp256k1")
+	case multisig.PubKeyMultisigThreshold:
+		var multisignature multisig.Multisignature
+		err := msgCdc.UnmarshalBinaryBare(sig, &multisignature)
+		if err != nil {
+			// a malformed multisignature fails verification without
+			// verifying any sub-signature
+			return
+		}
+		consumeMultisignatureVerificationGas(meter, params, multisignature, pubkey)
 	default:
 		panic("Unrecognized signature type")
 	}
 }
 
+func consumeMultisignatureVerificationGas(meter sdk.GasMeter, params Params,
+	sig multisig.Multisignature, pubkey multisig.PubKeyMultisigThreshold) {
+
+	size := sig.BitArray.Size()
+	sigIndex := 0
+	for i := 0; i < size && i < len(pubkey.PubKeys) && sigIndex < len(sig.Sigs); i++ {
+		if sig.BitArray.GetIndex(i) {
+			consumeSignatureVerificationGas(meter, params, sig.Sigs[sigIndex], pubkey.PubKeys[i])
+			sigIndex++
+		}
+	}
+}
+
 // Deduct the fee from the account.
 // We could use the CoinKeeper (in addition to the AccountMapper,
 // because the CoinKeeper doesn't give us accounts), but it seems easier to do this.
-func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
+// Vesting accounts may only pay fees with their spendable coins.
+func deductFees(acc Account, fee StdFee, blockTime time.Time) (Account, sdk.Result) {
 	coins := acc.GetCoins()
 	feeAmount := fee.Amount
 
-	newCoins := coins.Minus(feeAmount)
-	if !newCoins.IsNotNegative() {
-		errMsg := fmt.Sprintf("%s < %s", coins, feeAmount)
+	spendableCoins := SpendableCoins(acc, blockTime)
+	if !spendableCoins.Minus(feeAmount).IsNotNegative() {
+		errMsg := fmt.Sprintf("%s < %s", spendableCoins, feeAmount)
 		return nil, sdk.ErrInsufficientFunds(errMsg).Result()
 	}
+	newCoins := coins.Minus(feeAmount)
 	err := acc.SetCoins(newCoins)
 	if err != nil {
 		// Handle w/ #870
@@ -234,5 +330,54 @@ func deductFees(acc Account, fee StdFee) (Account, sdk.Result) {
 	return acc, sdk.Result{}
 }
 
+// Deduct the fees from the account of the fee granter, charging them to the
+// allowance granted to the grantee.
+func deductGrantedFees(ctx sdk.Context, am AccountMapper, fgk FeeGrantKeeper, fee StdFee, grantee sdk.AccAddress) sdk.Result {
+	if fgk == nil {
+		return sdk.ErrUnauthorized("fee grants are not supported").Result()
+	}
+	if fee.Granter.Equals(grantee) {
+		return sdk.ErrUnauthorized("the fee payer can not be its own fee granter").Result()
+	}
+	granterAcc := am.GetAccount(ctx, fee.Granter)
+	if granterAcc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("fee granter %s does not exist", fee.Granter)).Result()
+	}
+
+	// the allowance is only charged once the granter can pay the fees, as
+	// the ante handler state is not reverted when the tx is rejected
+	granterAcc, res := deductFees(granterAcc, fee, ctx.BlockHeader().Time)
+	if !res.IsOK() {
+		return res
+	}
+
+	err := fgk.UseGrantedFees(ctx, fee.Granter, grantee, fee.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	am.SetAccount(ctx, granterAcc)
+	return sdk.Result{}
+}
+
+// ensureSufficientMempoolFees verifies that the fee pays at least the node's
+// minimum gas price, fee >= gas * price, for one of the accepted denominations
+func ensureSufficientMempoolFees(ctx sdk.Context, fee StdFee) sdk.Result {
+	minGasPrices := ctx.MinimumGasPrices()
+	if minGasPrices.IsZero() {
+		return sdk.Result{}
+	}
+
+	for _, gasPrice := range minGasPrices {
+		requiredFee := gasPrice.Amount.Mul(sdk.NewDec(fee.Gas))
+		if sdk.NewDecFromInt(fee.Amount.AmountOf(gasPrice.Denom)).GTE(requiredFee) {
+			return sdk.Result{}
+		}
+	}
+
+	requiredFees := minGasPrices.MulDec(sdk.NewDec(fee.Gas))
+	errMsg := fmt.Sprintf("insufficient fees; got: %s, required one of: %s", fee.Amount, requiredFees)
+	return sdk.ErrInsufficientFee(errMsg).Result()
+}
+
 // BurnFeeHandler burns all fees (decreasing total supply)
 func BurnFeeHandler(_ sdk.Context, _ sdk.Tx, _ sdk.Coins) {}
@@ -10,8 +10,10 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/crypto/multisig"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
+	authtags "github.com/cosmos/cosmos-sdk/x/auth/tags"
 )
 
 func newTestMsg(addrs ...sdk.AccAddress) *sdk.TestMsg {
@@ -67,7 +69,7 @@ func checkInvalidTx(t *testing.T, anteHandler sdk.AnteHandler, ctx sdk.Context,
 func newTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "")
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, "", 0)
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
 			panic(err)
@@ -81,7 +83,7 @@ func newTestTx(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums
 func newTestTxWithMemo(ctx sdk.Context, msgs []sdk.Msg, privs []crypto.PrivKey, accNums []int64, seqs []int64, fee StdFee, memo string) sdk.Tx {
 	sigs := make([]StdSignature, len(privs))
 	for i, priv := range privs {
-		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo)
+		signBytes := StdSignBytes(ctx.ChainID(), accNums[i], seqs[i], fee, msgs, memo, 0)
 		sig, err := priv.Sign(signBytes)
 		if err != nil {
 			panic(err)
@@ -109,11 +111,11 @@ func newTestTxWithSignBytes(msgs []sdk.Msg, privs []crypto.PrivKey, accNums []in
 // Test various error cases in the AnteHandler control flow.
 func TestAnteHandlerSigErrors(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -162,11 +164,11 @@ func TestAnteHandlerSigErrors(t *testing.T) {
 // Test logic around account number checking with one signer and many signers.
 func TestAnteHandlerAccountNumbers(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -221,11 +223,11 @@ func TestAnteHandlerAccountNumbers(t *testing.T) {
 // Test logic around sequence checking with one signer and many signers.
 func TestAnteHandlerSequences(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -299,11 +301,11 @@ func TestAnteHandlerSequences(t *testing.T) {
 // Test logic around fee deduction.
 func TestAnteHandlerFees(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -338,14 +340,197 @@ func TestAnteHandlerFees(t *testing.T) {
 	require.True(t, feeCollector.GetCollectedFees(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("atom", 150)}))
 }
 
+// fee grant keeper which keeps the allowances in memory
+type testFeeGrantKeeper map[string]sdk.Coins
+
+func (fgk testFeeGrantKeeper) UseGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	key := granter.String() + grantee.String()
+	left := fgk[key].Minus(fee)
+	if !left.IsNotNegative() {
+		return sdk.ErrUnauthorized("fee allowance exceeded")
+	}
+	fgk[key] = left
+	return nil
+}
+
+func (fgk testFeeGrantKeeper) RestoreGrantedFees(_ sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) {
+	key := granter.String() + grantee.String()
+	fgk[key] = fgk[key].Plus(refund)
+}
+
+// Test logic around fees paid by a fee granter.
+func TestAnteHandlerFeeGrant(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	_, addr2 := privAndAddr()
+	_, addr3 := privAndAddr()
+
+	// the grantee has no coins, the granter pays its fees
+	fgk := testFeeGrantKeeper{addr2.String() + addr1.String(): sdk.Coins{sdk.NewInt64Coin("atom", 250)}}
+	anteHandler := NewAnteHandlerWithFeeGrants(mapper, feeCollector, fgk)
+
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	acc2.SetCoins(sdk.Coins{sdk.NewInt64Coin("atom", 1000)})
+	mapper.SetAccount(ctx, acc2)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []int64{0}, []int64{0}
+	msgs := []sdk.Msg{msg}
+
+	// fees are paid by the granter from the allowance
+	fee := newStdFee().WithGranter(addr2)
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	require.True(t, mapper.GetAccount(ctx, addr1).GetCoins().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 850)}, mapper.GetAccount(ctx, addr2).GetCoins())
+	require.True(t, feeCollector.GetCollectedFees(ctx).IsEqual(sdk.Coins{sdk.NewInt64Coin("atom", 150)}))
+
+	// the fee exceeds what is left of the allowance
+	seqs = []int64{1}
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// the fee granter does not exist
+	fee = newStdFee().WithGranter(addr3)
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnknownAddress)
+
+	// the granter can not pay the fees, the allowance is not charged
+	fgk[addr3.String()+addr1.String()] = sdk.Coins{sdk.NewInt64Coin("atom", 250)}
+	acc3 := mapper.NewAccountWithAddress(ctx, addr3)
+	acc3.SetCoins(sdk.Coins{sdk.NewInt64Coin("atom", 100)})
+	mapper.SetAccount(ctx, acc3)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeInsufficientFunds)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 250)}, fgk[addr3.String()+addr1.String()])
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 100)}, mapper.GetAccount(ctx, addr3).GetCoins())
+
+	// the fee payer can not grant its own fees
+	fgk[addr1.String()+addr1.String()] = sdk.Coins{sdk.NewInt64Coin("atom", 250)}
+	fee = newStdFee().WithGranter(addr1)
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+	require.True(t, mapper.GetAccount(ctx, addr1).GetCoins().IsZero())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("atom", 250)}, fgk[addr1.String()+addr1.String()])
+
+	// fee grants are not supported by the default ante handler
+	fee = newStdFee().WithGranter(addr2)
+	tx = newTestTx(ctx, msgs, privs, accnums, seqs, fee)
+	checkInvalidTx(t, NewAnteHandler(mapper, feeCollector), ctx, tx, false, sdk.CodeUnauthorized)
+}
+
+// Test logic around the tx timeout height.
+func TestAnteHandlerTimeoutHeight(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid", Height: 10}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+
+	msgs := []sdk.Msg{newTestMsg(addr1)}
+	fee := newStdFee()
+	newTimeoutTx := func(seq, timeoutHeight int64) sdk.Tx {
+		signBytes := StdSignBytes(ctx.ChainID(), 0, seq, fee, msgs, "", timeoutHeight)
+		sig, err := priv1.Sign(signBytes)
+		require.Nil(t, err)
+		sigs := []StdSignature{{PubKey: priv1.PubKey(), Signature: sig, AccountNumber: 0, Sequence: seq}}
+		return NewStdTx(msgs, fee, sigs, "").WithTimeoutHeight(timeoutHeight)
+	}
+
+	// the block height is above the timeout height
+	checkInvalidTx(t, anteHandler, ctx, newTimeoutTx(0, 9), false, sdk.CodeTxTimeoutHeight)
+
+	// the tx is valid up to its timeout height
+	checkValidTx(t, anteHandler, ctx, newTimeoutTx(0, 10), false)
+
+	// the timeout height is signed
+	tx := newTimeoutTx(1, 9).(StdTx).WithTimeoutHeight(11)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// a tx without a timeout height does not time out
+	checkValidTx(t, anteHandler, ctx, newTimeoutTx(1, 0), false)
+}
+
+// Test the tags of the signers, fee and msg types of a tx.
+func TestAnteHandlerTags(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	priv2, addr2 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	mapper.SetAccount(ctx, acc2)
+
+	// every signer is tagged, the first signer pays the fee
+	msgs := []sdk.Msg{newTestMsg(addr1), newTestMsg(addr2)}
+	fee := newStdFee()
+	tx := newTestTx(ctx, msgs, []crypto.PrivKey{priv1, priv2}, []int64{0, 1}, []int64{0, 0}, fee)
+	_, res, abort := anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	expected := sdk.NewTags(
+		authtags.Signer, []byte(addr1.String()),
+		authtags.Signer, []byte(addr2.String()),
+		authtags.FeePayer, []byte(addr1.String()),
+		authtags.Fee, []byte(fee.Amount.String()),
+		authtags.MsgType, []byte("TestMsg"),
+	)
+	require.Equal(t, expected, res.Tags)
+
+	// a tx without fees has no fee payer
+	fee = NewStdFee(5000, sdk.NewInt64Coin("atom", 0))
+	tx = newTestTx(ctx, msgs[1:], []crypto.PrivKey{priv2}, []int64{1}, []int64{1}, fee)
+	_, res, abort = anteHandler(ctx, tx, false)
+	require.False(t, abort)
+	expected = sdk.NewTags(
+		authtags.Signer, []byte(addr2.String()),
+		authtags.MsgType, []byte("TestMsg"),
+	)
+	require.Equal(t, expected, res.Tags)
+}
+
 // Test logic around memo gas consumption.
 func TestAnteHandlerMemoGas(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -382,13 +567,72 @@ func TestAnteHandlerMemoGas(t *testing.T) {
 	checkValidTx(t, anteHandler, ctx, tx, false)
 }
 
+// Test logic around the node's minimum gas prices.
+func TestAnteHandlerMinimumGasPrices(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	minGasPrices, err := sdk.ParseDecCoins("0.05atom")
+	require.Nil(t, err)
+	checkCtx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, true, log.NewNopLogger()).
+		WithMinimumGasPrices(minGasPrices)
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	acc1.SetCoins(sdk.Coins{sdk.NewInt64Coin("atom", 10000000), sdk.NewInt64Coin("photon", 10000000)})
+	mapper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []int64{0}, []int64{0}
+
+	// fee is below gas * price in CheckTx
+	fee := NewStdFee(5000, sdk.NewInt64Coin("atom", 249))
+	tx = newTestTx(checkCtx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, checkCtx, tx, false, sdk.CodeInsufficientFee)
+
+	// minimum gas prices are not enforced in DeliverTx
+	tx = newTestTx(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, ctx, tx, false)
+
+	// fee meets gas * price in CheckTx
+	seqs = []int64{1}
+	fee = NewStdFee(5000, sdk.NewInt64Coin("atom", 250))
+	tx = newTestTx(checkCtx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, checkCtx, tx, false)
+
+	// fee in a denomination which is not accepted
+	minGasPrices, err = sdk.ParseDecCoins("0.05atom,0.01photon")
+	require.Nil(t, err)
+	checkCtx = checkCtx.WithMinimumGasPrices(minGasPrices)
+	seqs = []int64{2}
+	fee = NewStdFee(5000, sdk.NewInt64Coin("steak", 1000))
+	tx = newTestTx(checkCtx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkInvalidTx(t, anteHandler, checkCtx, tx, false, sdk.CodeInsufficientFee)
+
+	// fee meets the gas price of any accepted denomination
+	fee = NewStdFee(5000, sdk.NewInt64Coin("photon", 50))
+	tx = newTestTx(checkCtx, []sdk.Msg{msg}, privs, accnums, seqs, fee)
+	checkValidTx(t, anteHandler, checkCtx, tx, false)
+}
+
 func TestAnteHandlerMultiSigner(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -435,11 +679,11 @@ func TestAnteHandlerMultiSigner(t *testing.T) {
 
 func TestAnteHandlerBadSignBytes(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -494,7 +738,7 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 		tx := newTestTxWithSignBytes(
 
 			msgs, privs, accnums, seqs, fee,
-			StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, ""),
+			StdSignBytes(cs.chainID, cs.accnum, cs.seq, cs.fee, cs.msgs, "", 0),
 			"",
 		)
 		checkInvalidTx(t, anteHandler, ctx, tx, false, cs.code)
@@ -516,11 +760,11 @@ func TestAnteHandlerBadSignBytes(t *testing.T) {
 
 func TestAnteHandlerSetPubKey(t *testing.T) {
 	// setup
-	ms, capKey, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
 	RegisterBaseAccount(cdc)
 	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
-	feeCollector := NewFeeCollectionKeeper(cdc, capKey2)
+	feeCollector := NewFeeCollectionKeeper(mapper)
 	anteHandler := NewAnteHandler(mapper, feeCollector)
 	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
 
@@ -567,3 +811,104 @@ func TestAnteHandlerSetPubKey(t *testing.T) {
 	acc2 = mapper.GetAccount(ctx, addr2)
 	require.Nil(t, acc2.GetPubKey())
 }
+
+// generate a multisig of the signatures of the given keys over the sign bytes
+func newTestMultisignature(signBytes []byte, pubKeys []crypto.PubKey, privs ...crypto.PrivKey) []byte {
+	multisignature := multisig.NewMultisig(len(pubKeys))
+	for _, priv := range privs {
+		sig, err := priv.Sign(signBytes)
+		if err != nil {
+			panic(err)
+		}
+		err = multisignature.AddSignatureFromPubKey(sig, priv.PubKey(), pubKeys)
+		if err != nil {
+			panic(err)
+		}
+	}
+	return multisignature.Marshal()
+}
+
+// Test a 2 of 3 multisig account as signer.
+func TestAnteHandlerMultisig(t *testing.T) {
+	// setup
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	anteHandler := NewAnteHandler(mapper, feeCollector)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, _ := privAndAddr()
+	priv2, _ := privAndAddr()
+	priv3, _ := privAndAddr()
+	pubKeys := []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	addr := sdk.AccAddress(multisigKey.Address())
+
+	// set the account
+	acc := mapper.NewAccountWithAddress(ctx, addr)
+	acc.SetCoins(newCoins())
+	mapper.SetAccount(ctx, acc)
+
+	msgs := []sdk.Msg{newTestMsg(addr)}
+	fee := newStdFee()
+	newMultisigTx := func(seq int64, privs ...crypto.PrivKey) sdk.Tx {
+		signBytes := StdSignBytes(ctx.ChainID(), 0, seq, fee, msgs, "", 0)
+		sig := StdSignature{
+			PubKey:        multisigKey,
+			Signature:     newTestMultisignature(signBytes, pubKeys, privs...),
+			AccountNumber: 0,
+			Sequence:      seq,
+		}
+		return NewStdTx(msgs, fee, []StdSignature{sig}, "")
+	}
+
+	// signatures under the threshold are rejected
+	checkInvalidTx(t, anteHandler, ctx, newMultisigTx(0, priv1), false, sdk.CodeUnauthorized)
+	checkInvalidTx(t, anteHandler, ctx, newMultisigTx(0), false, sdk.CodeUnauthorized)
+
+	// a signature by a key which is not part of the multisig is rejected
+	priv4, _ := privAndAddr()
+	tx := newMultisigTx(0, priv1)
+	signBytes := StdSignBytes(ctx.ChainID(), 0, 0, fee, msgs, "", 0)
+	sigs := tx.(StdTx).GetSignatures()
+	sigs[0].Signature = newTestMultisignature(signBytes, append(pubKeys[:2:2], priv4.PubKey()), priv1, priv4)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// a malformed multisignature is rejected
+	sigs[0].Signature = []byte("not a multisignature")
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeUnauthorized)
+
+	// the threshold of signatures is accepted and sets the multisig public key
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(0, priv1, priv3), false)
+	acc = mapper.GetAccount(ctx, addr)
+	require.True(t, multisigKey.Equals(acc.GetPubKey()))
+
+	// more signatures than the threshold are accepted too
+	checkValidTx(t, anteHandler, ctx, newMultisigTx(1, priv1, priv2, priv3), false)
+}
+
+func TestConsumeMultisignatureVerificationGas(t *testing.T) {
+	priv1, _ := privAndAddr()
+	priv2, _ := privAndAddr()
+	priv3, _ := privAndAddr()
+	pubKeys := []crypto.PubKey{priv1.PubKey(), priv2.PubKey(), priv3.PubKey()}
+	multisigKey := multisig.NewPubKeyMultisigThreshold(2, pubKeys)
+	msg := []byte{1, 2, 3, 4}
+	params := DefaultParams()
+
+	// each sub-signature is charged
+	for i, privs := range [][]crypto.PrivKey{{priv1}, {priv1, priv3}, {priv1, priv2, priv3}} {
+		meter := sdk.NewInfiniteGasMeter()
+		sig := newTestMultisignature(msg, pubKeys, privs...)
+		consumeSignatureVerificationGas(meter, params, sig, multisigKey)
+		require.Equal(t, params.Ed25519VerifyCost*sdk.Gas(len(privs)), meter.GasConsumed(), "test: %v", i)
+	}
+
+	// a malformed multisignature is not charged
+	meter := sdk.NewInfiniteGasMeter()
+	consumeSignatureVerificationGas(meter, params, []byte("not a multisignature"), multisigKey)
+	require.Equal(t, sdk.Gas(0), meter.GasConsumed())
+}
@@ -20,6 +20,8 @@ type TxContext struct {
 	ChainID       string
 	Memo          string
 	Fee           string
+	FeeGranter    string
+	TimeoutHeight int64
 }
 
 // NewTxContextFromCLI returns a new initialized TxContext with parameters from
@@ -40,6 +42,8 @@ func NewTxContextFromCLI() TxContext {
 		AccountNumber: viper.GetInt64(client.FlagAccountNumber),
 		Sequence:      viper.GetInt64(client.FlagSequence),
 		Fee:           viper.GetString(client.FlagFee),
+		FeeGranter:    viper.GetString(client.FlagFeeGranter),
+		TimeoutHeight: viper.GetInt64(client.FlagTimeoutHeight),
 		Memo:          viper.GetString(client.FlagMemo),
 	}
 }
@@ -68,6 +72,19 @@ func (ctx TxContext) WithFee(fee string) TxContext {
 	return ctx
 }
 
+// WithFeeGranter returns a copy of the context with an updated fee granter.
+func (ctx TxContext) WithFeeGranter(feeGranter string) TxContext {
+	ctx.FeeGranter = feeGranter
+	return ctx
+}
+
+// WithTimeoutHeight returns a copy of the context with an updated timeout
+// height.
+func (ctx TxContext) WithTimeoutHeight(height int64) TxContext {
+	ctx.TimeoutHeight = height
+	return ctx
+}
+
 // WithSequence returns a copy of the context with an updated sequence number.
 func (ctx TxContext) WithSequence(sequence int64) TxContext {
 	ctx.Sequence = sequence
@@ -87,7 +104,8 @@ func (ctx TxContext) WithAccountNumber(accnum int64) TxContext {
 }
 
 // Build builds a single message to be signed from a TxContext given a set of
-// messages. It returns an error if a fee is supplied but cannot be parsed.
+// messages. It returns an error if a fee or fee granter is supplied but cannot
+// be parsed.
 func (ctx TxContext) Build(msgs []sdk.Msg) (auth.StdSignMsg, error) {
 	chainID := ctx.ChainID
 	if chainID == "" {
@@ -104,13 +122,24 @@ func (ctx TxContext) Build(msgs []sdk.Msg) (auth.StdSignMsg, error) {
 		fee = parsedFee
 	}
 
+	stdFee := auth.NewStdFee(ctx.Gas, fee)
+	if ctx.FeeGranter != "" {
+		granter, err := sdk.AccAddressFromBech32(ctx.FeeGranter)
+		if err != nil {
+			return auth.StdSignMsg{}, err
+		}
+
+		stdFee = stdFee.WithGranter(granter)
+	}
+
 	return auth.StdSignMsg{
 		ChainID:       ctx.ChainID,
 		AccountNumber: ctx.AccountNumber,
 		Sequence:      ctx.Sequence,
 		Memo:          ctx.Memo,
 		Msgs:          msgs,
-		Fee:           auth.NewStdFee(ctx.Gas, fee),
+		Fee:           stdFee,
+		TimeoutHeight: ctx.TimeoutHeight,
 	}, nil
 }
 
@@ -134,7 +163,8 @@ func (ctx TxContext) Sign(name, passphrase string, msg auth.StdSignMsg) ([]byte,
 		Signature:     sig,
 	}}
 
-	return ctx.Codec.MarshalBinary(auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo))
+	tx := auth.NewStdTx(msg.Msgs, msg.Fee, sigs, msg.Memo).WithTimeoutHeight(msg.TimeoutHeight)
+	return ctx.Codec.MarshalBinary(tx)
 }
 
 // BuildAndSign builds a single message to be signed, and signs a transaction
@@ -20,6 +20,10 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, s
 		"/accounts/{address}",
 		QueryAccountRequestHandlerFn(storeName, cdc, authcmd.GetAccountDecoder(cdc), cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/auth/parameters",
+		QueryParamsRequestHandlerFn("auth", cliCtx),
+	).Methods("GET")
 }
 
 // query accountREST Handler
@@ -66,3 +70,16 @@ func QueryAccountRequestHandlerFn(
 		w.Write(output)
 	}
 }
+
+// query auth params REST Handler
+func QueryParamsRequestHandlerFn(queryRoute string, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/parameters", queryRoute), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query auth params. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
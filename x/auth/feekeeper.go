@@ -2,61 +2,60 @@ package auth
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	wire "github.com/cosmos/cosmos-sdk/wire"
 )
 
-var (
-	collectedFeesKey = []byte("collectedFees")
-)
+// name of the module account holding the collected fees
+const FeeCollectorName = "fee_collector"
 
-// This FeeCollectionKeeper handles collection of fees in the anteHandler
-// and setting of MinFees for different fee tokens
+// This FeeCollectionKeeper handles collection of fees in the anteHandler,
+// the collected fees are held by the fee collector module account
 type FeeCollectionKeeper struct {
 
-	// The (unexposed) key used to access the fee store from the Context.
-	key sdk.StoreKey
-
-	// The wire codec for binary encoding/decoding of accounts.
-	cdc *wire.Codec
+	// The AccountMapper holding the fee collector account.
+	am AccountMapper
 }
 
 // NewFeeKeeper returns a new FeeKeeper
-func NewFeeCollectionKeeper(cdc *wire.Codec, key sdk.StoreKey) FeeCollectionKeeper {
+func NewFeeCollectionKeeper(am AccountMapper) FeeCollectionKeeper {
 	return FeeCollectionKeeper{
-		key: key,
-		cdc: cdc,
+		am: am,
 	}
 }
 
-// Adds to Collected Fee Pool
+// Gets the Collected Fee Pool
 func (fck FeeCollectionKeeper) GetCollectedFees(ctx sdk.Context) sdk.Coins {
-	store := ctx.KVStore(fck.key)
-	bz := store.Get(collectedFeesKey)
-	if bz == nil {
+	acc := fck.am.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+	if acc == nil {
 		return sdk.Coins{}
 	}
-
-	feePool := &(sdk.Coins{})
-	fck.cdc.MustUnmarshalBinary(bz, feePool)
-	return *feePool
+	return acc.GetCoins()
 }
 
 // Sets to Collected Fee Pool
 func (fck FeeCollectionKeeper) setCollectedFees(ctx sdk.Context, coins sdk.Coins) {
-	bz := fck.cdc.MustMarshalBinary(coins)
-	store := ctx.KVStore(fck.key)
-	store.Set(collectedFeesKey, bz)
+	macc := fck.am.GetModuleAccount(ctx, FeeCollectorName, Holder)
+	err := macc.SetCoins(coins)
+	if err != nil {
+		panic(err)
+	}
+	fck.am.SetAccount(ctx, macc)
 }
 
 // Adds to Collected Fee Pool
-func (fck FeeCollectionKeeper) addCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
+func (fck FeeCollectionKeeper) AddCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
 	newCoins := fck.GetCollectedFees(ctx).Plus(coins)
 	fck.setCollectedFees(ctx, newCoins)
 
 	return newCoins
 }
 
-// Clears the collected Fee Pool
-func (fck FeeCollectionKeeper) ClearCollectedFees(ctx sdk.Context) {
-	fck.setCollectedFees(ctx, sdk.Coins{})
+// Refunds from the Collected Fee Pool
+func (fck FeeCollectionKeeper) refundCollectedFees(ctx sdk.Context, coins sdk.Coins) sdk.Coins {
+	newCoins := fck.GetCollectedFees(ctx).Minus(coins)
+	if !newCoins.IsNotNegative() {
+		panic("refunded more fees than were collected")
+	}
+	fck.setCollectedFees(ctx, newCoins)
+
+	return newCoins
 }
@@ -19,12 +19,14 @@ var (
 )
 
 func TestFeeCollectionKeeperGetSet(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
 
 	// make context and keeper
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	fck := NewFeeCollectionKeeper(mapper)
 
 	// no coins initially
 	currFees := fck.GetCollectedFees(ctx)
@@ -35,41 +37,30 @@ func TestFeeCollectionKeeperGetSet(t *testing.T) {
 
 	// check that it is equal to oneCoin
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(oneCoin))
+
+	// the fees are held by the fee collector module account
+	acc := mapper.GetAccount(ctx, NewModuleAddress(FeeCollectorName))
+	require.True(t, acc.GetCoins().IsEqual(oneCoin))
 }
 
 func TestFeeCollectionKeeperAdd(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
+	ms, capKey, _ := setupMultiStore()
 	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
 
 	// make context and keeper
 	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	fck := NewFeeCollectionKeeper(mapper)
 
 	// no coins initially
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
 
 	// add oneCoin and check that pool is now oneCoin
-	fck.addCollectedFees(ctx, oneCoin)
+	fck.AddCollectedFees(ctx, oneCoin)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(oneCoin))
 
 	// add oneCoin again and check that pool is now twoCoins
-	fck.addCollectedFees(ctx, oneCoin)
+	fck.AddCollectedFees(ctx, oneCoin)
 	require.True(t, fck.GetCollectedFees(ctx).IsEqual(twoCoins))
 }
-
-func TestFeeCollectionKeeperClear(t *testing.T) {
-	ms, _, capKey2 := setupMultiStore()
-	cdc := wire.NewCodec()
-
-	// make context and keeper
-	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
-	fck := NewFeeCollectionKeeper(cdc, capKey2)
-
-	// set coins initially
-	fck.setCollectedFees(ctx, twoCoins)
-	require.True(t, fck.GetCollectedFees(ctx).IsEqual(twoCoins))
-
-	// clear fees and see that pool is now empty
-	fck.ClearCollectedFees(ctx)
-	require.True(t, fck.GetCollectedFees(ctx).IsEqual(emptyCoins))
-}
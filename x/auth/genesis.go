@@ -0,0 +1,43 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all auth state that must be provided at genesis
+type GenesisState struct {
+	Params Params `json:"params"`
+}
+
+func NewGenesisState(params Params) GenesisState {
+	return GenesisState{
+		Params: params,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// InitGenesis sets the auth parameters for genesis
+func InitGenesis(ctx sdk.Context, pk ParamsKeeper, data GenesisState) {
+	err := ValidateGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+	pk.SetParams(ctx, data.Params)
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper.
+func WriteGenesis(ctx sdk.Context, pk ParamsKeeper) GenesisState {
+	params := pk.GetParams(ctx)
+	return NewGenesisState(params)
+}
+
+// ValidateGenesis validates the provided auth genesis state
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// permissions of a module over the coins of its module account
+const (
+	Holder = "holder" // holds coins and sends them to accounts
+	Minter = "minter" // may also mint coins
+	Burner = "burner" // may also burn coins
+)
+
+//-----------------------------------------------------------
+// ModuleAccount
+
+var _ Account = (*ModuleAccount)(nil)
+
+// ModuleAccount holds the coins owned by a module at an address derived from
+// the module name. It has no public key, so it can not sign txs and its coins
+// only move through the module.
+type ModuleAccount struct {
+	*BaseAccount
+
+	Name       string `json:"name"`       // name of the module owning the account
+	Permission string `json:"permission"` // permission of the module over the coins
+}
+
+// NewModuleAddress returns the deterministic address of the account of a
+// module.
+func NewModuleAddress(name string) sdk.AccAddress {
+	return sdk.AccAddress(crypto.AddressHash([]byte(name)))
+}
+
+// NewModuleAccount returns a ModuleAccount for the named module holding the
+// coins of the given account.
+func NewModuleAccount(baseAcc *BaseAccount, name, permission string) *ModuleAccount {
+	return &ModuleAccount{
+		BaseAccount: baseAcc,
+		Name:        name,
+		Permission:  permission,
+	}
+}
+
+// Implements sdk.Account.
+func (ma ModuleAccount) SetPubKey(pubKey crypto.PubKey) error {
+	return errors.New("cannot set the public key of a module account")
+}
+
+// HasPermission returns whether the module may perform the action of the
+// given permission with the coins of its account, every module account holds
+// coins.
+func (ma ModuleAccount) HasPermission(permission string) bool {
+	return permission == Holder || permission == ma.Permission
+}
+
+//-----------------------------------------------------------
+// AccountMapper
+
+// GetModuleAccount returns the account of a module, creating it with the given
+// permission if it does not exist yet. An account created at the module
+// address before, by coins sent to it, becomes the module account.
+func (am AccountMapper) GetModuleAccount(ctx sdk.Context, name, permission string) *ModuleAccount {
+	addr := NewModuleAddress(name)
+	acc := am.GetAccount(ctx, addr)
+	if macc, ok := acc.(*ModuleAccount); ok {
+		return macc
+	}
+
+	baseAcc := &BaseAccount{Address: addr}
+	if acc == nil {
+		baseAcc.AccountNumber = am.GetNextAccountNumber(ctx)
+	} else {
+		baseAcc.Coins = acc.GetCoins()
+		baseAcc.AccountNumber = acc.GetAccountNumber()
+	}
+
+	macc := NewModuleAccount(baseAcc, name, permission)
+	am.SetAccount(ctx, macc)
+	return macc
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+func TestModuleAccountPermissions(t *testing.T) {
+	macc := NewModuleAccount(&BaseAccount{Address: NewModuleAddress("test")}, "test", Minter)
+	require.True(t, macc.HasPermission(Holder))
+	require.True(t, macc.HasPermission(Minter))
+	require.False(t, macc.HasPermission(Burner))
+
+	// module accounts can not sign txs
+	priv, _ := privAndAddr()
+	require.NotNil(t, macc.SetPubKey(priv.PubKey()))
+	require.Nil(t, macc.GetPubKey())
+}
+
+func TestAccountMapperGetModuleAccount(t *testing.T) {
+	ms, capKey, _ := setupMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+
+	// the module account is created at the module address
+	macc := mapper.GetModuleAccount(ctx, "test", Burner)
+	require.Equal(t, NewModuleAddress("test"), macc.GetAddress())
+	require.Equal(t, "test", macc.Name)
+	require.Equal(t, Burner, macc.Permission)
+	require.Equal(t, macc, mapper.GetAccount(ctx, macc.GetAddress()))
+
+	// an account holding coins at the module address becomes the module account
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	acc := mapper.NewAccountWithAddress(ctx, NewModuleAddress("other"))
+	acc.SetCoins(coins)
+	mapper.SetAccount(ctx, acc)
+
+	macc = mapper.GetModuleAccount(ctx, "other", Holder)
+	require.Equal(t, coins, macc.GetCoins())
+	require.Equal(t, acc.GetAccountNumber(), macc.GetAccountNumber())
+	_, ok := mapper.GetAccount(ctx, macc.GetAddress()).(*ModuleAccount)
+	require.True(t, ok)
+}
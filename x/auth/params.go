@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// nolint
+const (
+	ParamStoreKeyParams = "auth/params"
+)
+
+// auth parameters, used by the ante handler to bound the memo and to charge
+// gas for the work it performs
+type Params struct {
+	MaxMemoCharacters   int64   `json:"max_memo_characters"`   // maximum number of characters in a tx memo
+	MemoCostPerByte     sdk.Gas `json:"memo_cost_per_byte"`    // gas charged per byte of memo
+	Ed25519VerifyCost   sdk.Gas `json:"ed25519_verify_cost"`   // gas charged to verify an ed25519 signature
+	Secp256k1VerifyCost sdk.Gas `json:"secp256k1_verify_cost"` // gas charged to verify a secp256k1 signature
+	DeductFeesCost      sdk.Gas `json:"deduct_fees_cost"`      // gas charged to deduct the fees of a tx
+	FeeRefundRatio      sdk.Dec `json:"fee_refund_ratio"`      // share of the fees of unused gas refunded to the fee payer
+}
+
+// default auth module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxMemoCharacters:   100,
+		MemoCostPerByte:     1,
+		Ed25519VerifyCost:   59,
+		Secp256k1VerifyCost: 100,
+		DeductFeesCost:      10,
+		FeeRefundRatio:      sdk.OneDec(),
+	}
+}
+
+// validate the auth parameters
+func (p Params) Validate() error {
+	if p.MaxMemoCharacters < 0 {
+		return fmt.Errorf("auth max memo characters cannot be negative, is %d", p.MaxMemoCharacters)
+	}
+	if p.MemoCostPerByte < 0 {
+		return fmt.Errorf("auth memo cost per byte cannot be negative, is %d", p.MemoCostPerByte)
+	}
+	if p.Ed25519VerifyCost <= 0 {
+		return fmt.Errorf("auth ed25519 verify cost must be positive, is %d", p.Ed25519VerifyCost)
+	}
+	if p.Secp256k1VerifyCost <= 0 {
+		return fmt.Errorf("auth secp256k1 verify cost must be positive, is %d", p.Secp256k1VerifyCost)
+	}
+	if p.DeductFeesCost < 0 {
+		return fmt.Errorf("auth deduct fees cost cannot be negative, is %d", p.DeductFeesCost)
+	}
+	if p.FeeRefundRatio.IsNil() {
+		return fmt.Errorf("auth fee refund ratio must be set")
+	}
+	if p.FeeRefundRatio.LT(sdk.ZeroDec()) || p.FeeRefundRatio.GT(sdk.OneDec()) {
+		return fmt.Errorf("auth fee refund ratio must be between 0 and 1, is %v", p.FeeRefundRatio)
+	}
+	return nil
+}
+
+// ValidateParamsChange decodes and validates the auth parameters proposed by a
+// governance parameter change
+func ValidateParamsChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var params Params
+	err := cdc.UnmarshalJSON([]byte(value), &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, params.Validate()
+}
+
+//______________________________________________________________________
+
+// ParamsKeeper gets and sets the auth parameters in the global param store
+type ParamsKeeper struct {
+	cdc *wire.Codec
+
+	// The reference to the ParamSetter to get and set Global Params
+	ps params.Setter
+}
+
+// NewParamsKeeper returns a new ParamsKeeper
+func NewParamsKeeper(cdc *wire.Codec, ps params.Setter) ParamsKeeper {
+	return ParamsKeeper{
+		cdc: cdc,
+		ps:  ps,
+	}
+}
+
+// GetParams returns the current auth parameters from the global param store,
+// falling back to the default parameters if none have been set
+func (pk ParamsKeeper) GetParams(ctx sdk.Context) Params {
+	var params Params
+	err := pk.ps.Get(ctx, ParamStoreKeyParams, &params)
+	if err != nil {
+		return DefaultParams()
+	}
+	return params
+}
+
+// SetParams stores the auth parameters in the global param store
+func (pk ParamsKeeper) SetParams(ctx sdk.Context, params Params) {
+	pk.ps.Set(ctx, ParamStoreKeyParams, &params)
+}
@@ -0,0 +1,108 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func setupParamsMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey) {
+	db := dbm.NewMemDB()
+	capKey := sdk.NewKVStoreKey("capkey")
+	keyParams := sdk.NewKVStoreKey("params")
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(capKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.LoadLatestVersion()
+	return ms, capKey, keyParams
+}
+
+func TestParamsValidate(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+
+	tests := []func(p *Params){
+		func(p *Params) { p.MaxMemoCharacters = -1 },
+		func(p *Params) { p.MemoCostPerByte = -1 },
+		func(p *Params) { p.Ed25519VerifyCost = 0 },
+		func(p *Params) { p.Secp256k1VerifyCost = 0 },
+		func(p *Params) { p.DeductFeesCost = -1 },
+	}
+	for i, tc := range tests {
+		p := DefaultParams()
+		tc(&p)
+		require.NotNil(t, p.Validate(), "test: %v", i)
+	}
+}
+
+func TestParamsKeeperGetSet(t *testing.T) {
+	ms, _, keyParams := setupParamsMultiStore()
+	cdc := wire.NewCodec()
+	pk := NewParamsKeeper(cdc, params.NewKeeper(cdc, keyParams).Setter())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	// the default params are returned before any are set
+	require.Equal(t, DefaultParams(), pk.GetParams(ctx))
+
+	p := DefaultParams()
+	p.MaxMemoCharacters = 256
+	p.Secp256k1VerifyCost = 200
+	pk.SetParams(ctx, p)
+	require.Equal(t, p, pk.GetParams(ctx))
+
+	// the params round trip through genesis
+	require.Equal(t, NewGenesisState(p), WriteGenesis(ctx, pk))
+	require.Panics(t, func() { InitGenesis(ctx, pk, NewGenesisState(Params{})) })
+}
+
+// Test that the ante handler uses the params from the param store.
+func TestAnteHandlerParams(t *testing.T) {
+	// setup
+	ms, capKey, keyParams := setupParamsMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	pk := NewParamsKeeper(cdc, params.NewKeeper(cdc, keyParams).Setter())
+	anteHandler := NewAnteHandlerWithParams(mapper, feeCollector, nil, pk)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	mapper.SetAccount(ctx, acc1)
+
+	// msg and signatures
+	var tx sdk.Tx
+	msg := newTestMsg(addr1)
+	privs, accnums, seqs := []crypto.PrivKey{priv1}, []int64{0}, []int64{0}
+	fee := NewStdFee(10000, sdk.NewInt64Coin("atom", 0))
+	memo := "abcininasidniandsinasindiansdiansdinaisndiasndiadninsd"
+
+	// a lower memo limit rejects the memo
+	p := DefaultParams()
+	p.MaxMemoCharacters = 10
+	pk.SetParams(ctx, p)
+	tx = newTestTxWithMemo(ctx, []sdk.Msg{msg}, privs, accnums, seqs, fee, memo)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeMemoTooLarge)
+
+	// a higher memo cost runs out of gas
+	p = DefaultParams()
+	p.MemoCostPerByte = 1000
+	pk.SetParams(ctx, p)
+	checkInvalidTx(t, anteHandler, ctx, tx, false, sdk.CodeOutOfGas)
+
+	// the default params accept the tx
+	pk.SetParams(ctx, DefaultParams())
+	checkValidTx(t, anteHandler, ctx, tx, false)
+}
@@ -0,0 +1,28 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(pk ParamsKeeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case "parameters":
+			return queryParams(ctx, pk)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
+		}
+	}
+}
+
+func queryParams(ctx sdk.Context, pk ParamsKeeper) (res []byte, err sdk.Error) {
+	params := pk.GetParams(ctx)
+
+	bz, err2 := wire.MarshalJSONIndent(pk.cdc, params)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewFeeRefundHandler returns a FeeRefundHandler that refunds the share of
+// the fees paid for the gas a StdTx wanted but did not use, scaled by the fee
+// refund ratio of the auth params, from the fee collector to the fee payer:
+// the fee granter if one paid the fees, the first signer otherwise. Fees
+// refunded to a granter are given back to the allowance of the grantee.
+func NewFeeRefundHandler(am AccountMapper, fck FeeCollectionKeeper, fgk FeeGrantKeeper, pk ParamsKeeper) sdk.FeeRefundHandler {
+	return func(ctx sdk.Context, tx sdk.Tx, result sdk.Result) (refund sdk.Coins) {
+		stdTx, ok := tx.(StdTx)
+		if !ok {
+			return nil
+		}
+
+		fee := stdTx.Fee
+		if fee.Amount.IsZero() || result.GasWanted <= 0 || result.GasUsed >= result.GasWanted {
+			return nil
+		}
+
+		refund = refundFees(fee.Amount, result.GasWanted, result.GasUsed, pk.GetParams(ctx).FeeRefundRatio)
+		if refund.IsZero() {
+			return nil
+		}
+
+		grantee := stdTx.GetSigners()[0]
+		payer := fee.Granter
+		if len(payer) == 0 {
+			payer = grantee
+		} else if fgk != nil {
+			fgk.RestoreGrantedFees(ctx, fee.Granter, grantee, refund)
+		}
+		acc := am.GetAccount(ctx, payer)
+		if acc == nil {
+			panic("fee payer account does not exist")
+		}
+		err := acc.SetCoins(acc.GetCoins().Plus(refund))
+		if err != nil {
+			panic(err)
+		}
+		am.SetAccount(ctx, acc)
+		fck.refundCollectedFees(ctx, refund)
+
+		return refund
+	}
+}
+
+// the share of the fees paid for the unused gas, scaled by the refund ratio
+// and truncated to whole coins
+func refundFees(fees sdk.Coins, gasWanted, gasUsed sdk.Gas, ratio sdk.Dec) sdk.Coins {
+	unusedShare := sdk.NewDec(gasWanted - gasUsed).Quo(sdk.NewDec(gasWanted)).Mul(ratio)
+
+	var refund sdk.Coins
+	for _, fee := range fees {
+		amount := sdk.NewDecFromInt(fee.Amount).Mul(unusedShare).TruncateInt()
+		if amount.IsZero() {
+			continue
+		}
+		refund = append(refund, sdk.NewCoin(fee.Denom, amount))
+	}
+	return refund
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestRefundFees(t *testing.T) {
+	fees := sdk.Coins{sdk.NewInt64Coin("atom", 100), sdk.NewInt64Coin("photon", 3)}
+
+	tests := []struct {
+		gasWanted, gasUsed sdk.Gas
+		ratio              sdk.Dec
+		expected           sdk.Coins
+	}{
+		{1000, 1000, sdk.OneDec(), nil},
+		{1000, 0, sdk.OneDec(), fees},
+		{1000, 500, sdk.OneDec(), sdk.Coins{sdk.NewInt64Coin("atom", 50), sdk.NewInt64Coin("photon", 1)}},
+		{1000, 500, sdk.NewDecWithPrec(5, 1), sdk.Coins{sdk.NewInt64Coin("atom", 25)}},
+		{1000, 500, sdk.ZeroDec(), nil},
+	}
+	for i, tc := range tests {
+		refund := refundFees(fees, tc.gasWanted, tc.gasUsed, tc.ratio)
+		require.True(t, tc.expected.IsEqual(refund), "test: %v, refund: %v", i, refund)
+	}
+}
+
+func TestFeeRefundHandler(t *testing.T) {
+	// setup
+	ms, capKey, keyParams := setupParamsMultiStore()
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+	mapper := NewAccountMapper(cdc, capKey, ProtoBaseAccount)
+	feeCollector := NewFeeCollectionKeeper(mapper)
+	pk := NewParamsKeeper(cdc, params.NewKeeper(cdc, keyParams).Setter())
+	fgk := testFeeGrantKeeper{}
+	refundHandler := NewFeeRefundHandler(mapper, feeCollector, fgk, pk)
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "mychainid"}, false, log.NewNopLogger())
+
+	// keys and addresses
+	priv1, addr1 := privAndAddr()
+	_, addr2 := privAndAddr()
+
+	// set the accounts
+	acc1 := mapper.NewAccountWithAddress(ctx, addr1)
+	mapper.SetAccount(ctx, acc1)
+	acc2 := mapper.NewAccountWithAddress(ctx, addr2)
+	mapper.SetAccount(ctx, acc2)
+
+	fee := NewStdFee(1000, sdk.NewInt64Coin("atom", 100))
+	feeCollector.AddCollectedFees(ctx, fee.Amount)
+	msg := newTestMsg(addr1)
+	tx := newTestTx(ctx, []sdk.Msg{msg}, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}, fee)
+
+	// half of the unused gas fees are refunded to the first signer
+	p := DefaultParams()
+	p.FeeRefundRatio = sdk.NewDecWithPrec(5, 1)
+	pk.SetParams(ctx, p)
+	refund := refundHandler(ctx, tx, sdk.Result{GasWanted: 1000, GasUsed: 600})
+	require.True(t, sdk.Coins{sdk.NewInt64Coin("atom", 20)}.IsEqual(refund))
+	require.True(t, refund.IsEqual(mapper.GetAccount(ctx, addr1).GetCoins()))
+	require.True(t, sdk.Coins{sdk.NewInt64Coin("atom", 80)}.IsEqual(feeCollector.GetCollectedFees(ctx)))
+
+	// nothing is refunded once all the gas is used
+	refund = refundHandler(ctx, tx, sdk.Result{GasWanted: 1000, GasUsed: 1000})
+	require.True(t, refund.IsZero())
+	require.True(t, sdk.Coins{sdk.NewInt64Coin("atom", 80)}.IsEqual(feeCollector.GetCollectedFees(ctx)))
+
+	// fees paid by a fee granter are refunded to the granter and given back
+	// to the allowance of the grantee
+	tx = newTestTx(ctx, []sdk.Msg{msg}, []crypto.PrivKey{priv1}, []int64{0}, []int64{0}, fee.WithGranter(addr2))
+	refund = refundHandler(ctx, tx, sdk.Result{GasWanted: 1000, GasUsed: 0})
+	require.True(t, sdk.Coins{sdk.NewInt64Coin("atom", 50)}.IsEqual(refund))
+	require.True(t, refund.IsEqual(mapper.GetAccount(ctx, addr2).GetCoins()))
+	require.True(t, sdk.Coins{sdk.NewInt64Coin("atom", 30)}.IsEqual(feeCollector.GetCollectedFees(ctx)))
+	require.True(t, refund.IsEqual(fgk[addr2.String()+addr1.String()]))
+}
@@ -12,11 +12,13 @@ var _ sdk.Tx = (*StdTx)(nil)
 
 // StdTx is a standard way to wrap a Msg with Fee and Signatures.
 // NOTE: the first signature is the FeePayer (Signatures must not be nil).
+// If the timeout height is set, the tx is rejected in blocks above it.
 type StdTx struct {
-	Msgs       []sdk.Msg      `json:"msg"`
-	Fee        StdFee         `json:"fee"`
-	Signatures []StdSignature `json:"signatures"`
-	Memo       string         `json:"memo"`
+	Msgs          []sdk.Msg      `json:"msg"`
+	Fee           StdFee         `json:"fee"`
+	Signatures    []StdSignature `json:"signatures"`
+	Memo          string         `json:"memo"`
+	TimeoutHeight int64          `json:"timeout_height"`
 }
 
 func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdTx {
@@ -28,6 +30,13 @@ func NewStdTx(msgs []sdk.Msg, fee StdFee, sigs []StdSignature, memo string) StdT
 	}
 }
 
+// WithTimeoutHeight returns a copy of the tx which times out above the given
+// block height.
+func (tx StdTx) WithTimeoutHeight(height int64) StdTx {
+	tx.TimeoutHeight = height
+	return tx
+}
+
 //nolint
 func (tx StdTx) GetMsgs() []sdk.Msg { return tx.Msgs }
 
@@ -75,9 +84,12 @@ func FeePayer(tx sdk.Tx) sdk.AccAddress {
 // StdFee includes the amount of coins paid in fees and the maximum
 // gas to be used by the transaction. The ratio yields an effective "gasprice",
 // which must be above some miminum to be accepted into the mempool.
+// If a granter is set, the fees are paid by the granter from the fee
+// allowance it granted to the fee payer.
 type StdFee struct {
-	Amount sdk.Coins `json:"amount"`
-	Gas    int64     `json:"gas"`
+	Amount  sdk.Coins      `json:"amount"`
+	Gas     int64          `json:"gas"`
+	Granter sdk.AccAddress `json:"granter,omitempty"`
 }
 
 func NewStdFee(gas int64, amount ...sdk.Coin) StdFee {
@@ -87,6 +99,12 @@ func NewStdFee(gas int64, amount ...sdk.Coin) StdFee {
 	}
 }
 
+// WithGranter returns a copy of the fee paid by the given granter.
+func (fee StdFee) WithGranter(granter sdk.AccAddress) StdFee {
+	fee.Granter = granter
+	return fee
+}
+
 // fee bytes for signing later
 func (fee StdFee) Bytes() []byte {
 	// normalize. XXX
@@ -117,10 +135,11 @@ type StdSignDoc struct {
 	Memo          string            `json:"memo"`
 	Msgs          []json.RawMessage `json:"msgs"`
 	Sequence      int64             `json:"sequence"`
+	TimeoutHeight int64             `json:"timeout_height,omitempty"`
 }
 
 // StdSignBytes returns the bytes to sign for a transaction.
-func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs []sdk.Msg, memo string, timeoutHeight int64) []byte {
 	var msgsBytes []json.RawMessage
 	for _, msg := range msgs {
 		msgsBytes = append(msgsBytes, json.RawMessage(msg.GetSignBytes()))
@@ -132,6 +151,7 @@ func StdSignBytes(chainID string, accnum int64, sequence int64, fee StdFee, msgs
 		Memo:          memo,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
+		TimeoutHeight: timeoutHeight,
 	})
 	if err != nil {
 		panic(err)
@@ -149,11 +169,12 @@ type StdSignMsg struct {
 	Fee           StdFee
 	Msgs          []sdk.Msg
 	Memo          string
+	TimeoutHeight int64
 }
 
 // get message bytes
 func (msg StdSignMsg) Bytes() []byte {
-	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo)
+	return StdSignBytes(msg.ChainID, msg.AccountNumber, msg.Sequence, msg.Fee, msg.Msgs, msg.Memo, msg.TimeoutHeight)
 }
 
 // Standard Signature
@@ -0,0 +1,9 @@
+// nolint
+package tags
+
+var (
+	Signer   = "signer"
+	FeePayer = "fee-payer"
+	Fee      = "fee"
+	MsgType  = "msg-type"
+)
@@ -0,0 +1,264 @@
+package auth
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// VestingAccount is an account whose coins are locked until they vest
+// according to a vesting schedule. Vesting coins may not be spent but may be
+// delegated.
+type VestingAccount interface {
+	Account
+
+	// coins which may be spent at the given time
+	SpendableCoins(blockTime time.Time) sdk.Coins
+	// account for a delegation of the given amount of coins
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	// account for the return of the given amount of undelegated coins
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() time.Time
+	GetEndTime() time.Time
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+}
+
+// spendable coins of an account at the given time, all coins of an account
+// which is not vesting are spendable
+func SpendableCoins(acc Account, blockTime time.Time) sdk.Coins {
+	vacc, ok := acc.(VestingAccount)
+	if !ok {
+		return acc.GetCoins()
+	}
+	return vacc.SpendableCoins(blockTime)
+}
+
+//-----------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount implements the vesting accounting common to all vesting
+// accounts. It is not an Account on its own, its vesting schedule is defined
+// by the accounts embedding it.
+type BaseVestingAccount struct {
+	*BaseAccount
+
+	OriginalVesting  sdk.Coins `json:"original_vesting"`  // coins vesting when the account was created
+	DelegatedFree    sdk.Coins `json:"delegated_free"`    // vested coins which are delegated
+	DelegatedVesting sdk.Coins `json:"delegated_vesting"` // vesting coins which are delegated
+	EndTime          time.Time `json:"end_time"`          // time at which all coins are vested
+}
+
+// spendable coins given the coins which are still vesting, per denomination
+// min(coins + delegatedVesting - vesting, coins)
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var spendableCoins sdk.Coins
+	for _, coin := range bva.Coins {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+
+		spendableAmt := sdk.MinInt(coin.Amount.Add(delVestingAmt).Sub(vestingAmt), coin.Amount)
+		if spendableAmt.GT(sdk.ZeroInt()) {
+			spendableCoins = spendableCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, spendableAmt)})
+		}
+	}
+	return spendableCoins
+}
+
+// track a delegation given the coins which are still vesting, the delegated
+// coins are taken from the vesting coins which are not yet delegated first
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	for _, coin := range amount {
+		if !coin.IsPositive() || bva.Coins.AmountOf(coin.Denom).LT(coin.Amount) {
+			panic("delegation attempt with non-positive coins or insufficient funds")
+		}
+
+		// delegated vesting: min(max(vesting - delegatedVesting, 0), amount)
+		vestingAmt := vestingCoins.AmountOf(coin.Denom).Sub(bva.DelegatedVesting.AmountOf(coin.Denom))
+		if vestingAmt.LT(sdk.ZeroInt()) {
+			vestingAmt = sdk.ZeroInt()
+		}
+		vestingAmt = sdk.MinInt(vestingAmt, coin.Amount)
+		freeAmt := coin.Amount.Sub(vestingAmt)
+
+		if !vestingAmt.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, vestingAmt)})
+		}
+		if !freeAmt.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, freeAmt)})
+		}
+		bva.Coins = bva.Coins.Minus(sdk.Coins{coin})
+	}
+}
+
+// Implements VestingAccount. The undelegated coins are accounted as delegated
+// free coins first so that a slashed delegation reduces the delegated
+// vesting coins.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		if !coin.IsPositive() {
+			panic("undelegation attempt with non-positive coins")
+		}
+
+		// delegated free: min(delegatedFree, amount)
+		freeAmt := sdk.MinInt(bva.DelegatedFree.AmountOf(coin.Denom), coin.Amount)
+		vestingAmt := sdk.MinInt(bva.DelegatedVesting.AmountOf(coin.Denom), coin.Amount.Sub(freeAmt))
+
+		if !freeAmt.IsZero() {
+			bva.DelegatedFree = bva.DelegatedFree.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, freeAmt)})
+		}
+		if !vestingAmt.IsZero() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Minus(sdk.Coins{sdk.NewCoin(coin.Denom, vestingAmt)})
+		}
+		bva.Coins = bva.Coins.Plus(sdk.Coins{coin})
+	}
+}
+
+// Implements VestingAccount.
+func (bva BaseVestingAccount) GetEndTime() time.Time {
+	return bva.EndTime
+}
+
+// Implements VestingAccount.
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// Implements VestingAccount.
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// Implements VestingAccount.
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+//-----------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ VestingAccount = (*ContinuousVestingAccount)(nil)
+
+// ContinuousVestingAccount vests its coins linearly from the start time until
+// the end time.
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+
+	StartTime time.Time `json:"start_time"` // time at which the coins start vesting
+}
+
+// NewContinuousVestingAccount returns a ContinuousVestingAccount vesting all
+// the coins of the given account between the start and end time.
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime time.Time) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: baseAcc.Coins,
+			EndTime:         endTime,
+		},
+		StartTime: startTime,
+	}
+}
+
+// Implements VestingAccount.
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	var vestedCoins sdk.Coins
+	if !blockTime.After(cva.StartTime) {
+		return vestedCoins
+	}
+	if !blockTime.Before(cva.EndTime) {
+		return cva.OriginalVesting
+	}
+
+	// the share of the vesting period which has passed
+	elapsed := blockTime.Unix() - cva.StartTime.Unix()
+	period := cva.EndTime.Unix() - cva.StartTime.Unix()
+	if period <= 0 {
+		return vestedCoins
+	}
+	vestedShare := sdk.NewDec(elapsed).Quo(sdk.NewDec(period))
+
+	for _, coin := range cva.OriginalVesting {
+		vestedAmt := sdk.NewDecFromInt(coin.Amount).Mul(vestedShare).TruncateInt()
+		if !vestedAmt.IsZero() {
+			vestedCoins = vestedCoins.Plus(sdk.Coins{sdk.NewCoin(coin.Denom, vestedAmt)})
+		}
+	}
+	return vestedCoins
+}
+
+// Implements VestingAccount.
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Minus(cva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount.
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount.
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount.
+func (cva ContinuousVestingAccount) GetStartTime() time.Time {
+	return cva.StartTime
+}
+
+//-----------------------------------------------------------
+// DelayedVestingAccount
+
+var _ VestingAccount = (*DelayedVestingAccount)(nil)
+
+// DelayedVestingAccount vests all its coins at once at the end time.
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount returns a DelayedVestingAccount vesting all the
+// coins of the given account at the end time.
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime time.Time) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: &BaseVestingAccount{
+			BaseAccount:     baseAcc,
+			OriginalVesting: baseAcc.Coins,
+			EndTime:         endTime,
+		},
+	}
+}
+
+// Implements VestingAccount.
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if !blockTime.Before(dva.EndTime) {
+		return dva.OriginalVesting
+	}
+	return nil
+}
+
+// Implements VestingAccount.
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Minus(dva.GetVestedCoins(blockTime))
+}
+
+// Implements VestingAccount.
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// Implements VestingAccount.
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// Implements VestingAccount. A delayed vesting account vests from the
+// beginning of time.
+func (dva DelayedVestingAccount) GetStartTime() time.Time {
+	return time.Time{}
+}
@@ -0,0 +1,206 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+)
+
+var (
+	stakeDenom = "steak"
+	feeDenom   = "fee"
+)
+
+func initVestingCoins() sdk.Coins {
+	return sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+}
+
+func TestContinuousVestingAccountVestedCoins(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+	cva := NewContinuousVestingAccount(&bacc, now, endTime)
+
+	// require no coins vested in the very beginning of the vesting schedule
+	require.Nil(t, cva.GetVestedCoins(now))
+	require.Equal(t, initVestingCoins(), cva.GetVestingCoins(now))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, initVestingCoins(), cva.GetVestedCoins(endTime))
+	require.Nil(t, cva.GetVestingCoins(endTime))
+
+	// require 50% of coins vested
+	halfCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+	require.Equal(t, halfCoins, cva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, halfCoins, cva.GetVestingCoins(now.Add(12*time.Hour)))
+}
+
+func TestDelayedVestingAccountVestedCoins(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+	dva := NewDelayedVestingAccount(&bacc, endTime)
+
+	// require no coins are vested until the end of the vesting schedule
+	require.Nil(t, dva.GetVestedCoins(now))
+	require.Nil(t, dva.GetVestedCoins(now.Add(12*time.Hour)))
+	require.Equal(t, initVestingCoins(), dva.GetVestingCoins(now.Add(12*time.Hour)))
+
+	// require all coins vested at the end of the vesting schedule
+	require.Equal(t, initVestingCoins(), dva.GetVestedCoins(endTime))
+	require.Nil(t, dva.GetVestingCoins(endTime))
+}
+
+func TestContinuousVestingAccountSpendableCoins(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+	cva := NewContinuousVestingAccount(&bacc, now, endTime)
+
+	// require that there exist no spendable coins at the beginning of the
+	// vesting schedule
+	require.Nil(t, cva.SpendableCoins(now))
+	require.Nil(t, SpendableCoins(cva, now))
+
+	// require that all original coins are spendable at the end of the vesting
+	// schedule
+	require.Equal(t, initVestingCoins(), cva.SpendableCoins(endTime))
+
+	// require that all vested coins (50%) are spendable
+	halfCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}
+	require.Equal(t, halfCoins, cva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// receive some coins, which are spendable right away
+	cva.SetCoins(cva.GetCoins().Plus(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.SpendableCoins(now))
+}
+
+func TestDelayedVestingAccountSpendableCoins(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+	dva := NewDelayedVestingAccount(&bacc, endTime)
+
+	// require that no coins are spendable until the end of the vesting schedule
+	require.Nil(t, dva.SpendableCoins(now))
+	require.Nil(t, dva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// require that all coins are spendable at the end of the vesting schedule
+	require.Equal(t, initVestingCoins(), dva.SpendableCoins(endTime))
+}
+
+func TestVestingAccountTrackDelegation(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+
+	// require the ability to delegate all vesting coins
+	cva := NewContinuousVestingAccount(&bacc, now, endTime)
+	cva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, cva.GetCoins())
+
+	// require the ability to delegate all vested coins
+	bacc.SetCoins(initVestingCoins())
+	cva = NewContinuousVestingAccount(&bacc, now, endTime)
+	cva.TrackDelegation(endTime, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	require.Nil(t, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, cva.DelegatedFree)
+
+	// require the ability to delegate all coins half way through the vesting
+	// schedule, the vesting coins are delegated first
+	bacc.SetCoins(initVestingCoins())
+	cva = NewContinuousVestingAccount(&bacc, now, endTime)
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 75)})
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedFree)
+
+	// delegated vesting coins do not make the vested coins unspendable
+	bacc.SetCoins(initVestingCoins())
+	dva := NewDelayedVestingAccount(&bacc, endTime)
+	dva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)})
+	require.Nil(t, dva.SpendableCoins(now))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 50)},
+		dva.SpendableCoins(endTime))
+
+	// require no modifications when delegation amount is zero or not enough funds
+	require.Panics(t, func() {
+		dva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000000)})
+	})
+	require.Panics(t, func() {
+		dva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
+	})
+}
+
+func TestVestingAccountTrackUndelegation(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+
+	// undelegated coins are accounted as delegated free coins first
+	cva := NewContinuousVestingAccount(&bacc, now, endTime)
+	cva.TrackDelegation(now.Add(12*time.Hour), sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)})
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 50)}, cva.DelegatedVesting)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 25)}, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 25)}, cva.GetCoins())
+
+	// once the delegated free coins are returned the delegated vesting coins
+	// are reduced
+	cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 45)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 30)}, cva.DelegatedVesting)
+	require.Nil(t, cva.DelegatedFree)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 70)}, cva.GetCoins())
+
+	require.Panics(t, func() {
+		cva.TrackUndelegation(sdk.Coins{sdk.NewInt64Coin(stakeDenom, 0)})
+	})
+}
+
+func TestVestingAccountMarshal(t *testing.T) {
+	cdc := wire.NewCodec()
+	RegisterBaseAccount(cdc)
+
+	now := time.Unix(0, 0).UTC()
+	_, _, addr := keyPubAddr()
+	bacc := NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(initVestingCoins())
+
+	accs := []Account{
+		NewContinuousVestingAccount(&bacc, now, now.Add(24*time.Hour)),
+		NewDelayedVestingAccount(&bacc, now.Add(24*time.Hour)),
+	}
+	for _, acc := range accs {
+		bz, err := cdc.MarshalBinaryBare(acc)
+		require.Nil(t, err)
+
+		var acc2 Account
+		err = cdc.UnmarshalBinaryBare(bz, &acc2)
+		require.Nil(t, err)
+		require.Equal(t, acc, acc2)
+	}
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/bank/client"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+const (
+	flagMaxSupply = "max-supply"
+	flagNewOwner  = "new-owner"
+	flagDenom     = "denom"
+)
+
+// IssueTxCmd will create an issue tx and sign it with the given key.
+func IssueTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Create and sign an issue tx, minting coins of denoms owned by the signer",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			to, err := sdk.AccAddressFromBech32(viper.GetString(flagTo))
+			if err != nil {
+				return err
+			}
+
+			// parse coins trying to be issued
+			coins, err := client.ParseCoins(cliCtx, queryRoute, viper.GetString(flagAmount))
+			if err != nil {
+				return err
+			}
+
+			maxSupply, err := client.ParseCoins(cliCtx, queryRoute, viper.GetString(flagMaxSupply))
+			if err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := bank.NewMsgIssue(from, []bank.Output{bank.NewOutput(to, coins)}, maxSupply)
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagTo, "", "Address to issue coins to")
+	cmd.Flags().String(flagAmount, "", "Amount of coins to issue")
+	cmd.Flags().String(flagMaxSupply, "", "Max supply of the denoms issued for the first time")
+
+	return cmd
+}
+
+// TransferOwnershipTxCmd will create a tx transferring the ownership of an
+// issued denom and sign it with the given key.
+func TransferOwnershipTxCmd(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-ownership",
+		Short: "Create and sign a tx transferring the ownership of an issued denom",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			newOwner, err := sdk.AccAddressFromBech32(viper.GetString(flagNewOwner))
+			if err != nil {
+				return err
+			}
+
+			from, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := bank.NewMsgTransferOwnership(from, newOwner, viper.GetString(flagDenom))
+
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagNewOwner, "", "Address of the new owner")
+	cmd.Flags().String(flagDenom, "", "Issued denom to transfer")
+
+	return cmd
+}
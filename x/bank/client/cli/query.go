@@ -0,0 +1,129 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+// GetCmdQueryBalances implements the query balances command.
+func GetCmdQueryBalances(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balances [address]",
+		Short: "Query the balances of an account, optionally of a single denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			var route string
+			var params interface{}
+			denom := viper.GetString(flagDenom)
+			if len(denom) == 0 {
+				route = "balances"
+				params = bank.QueryBalancesParams{
+					Address: addr,
+				}
+			} else {
+				route = "balance"
+				params = bank.QueryBalanceParams{
+					Address: addr,
+					Denom:   denom,
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, route), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagDenom, "", "Only query the balance of this denom")
+
+	return cmd
+}
+
+// GetCmdQuerySupply implements the query total supply command.
+func GetCmdQuerySupply(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply [denom]",
+		Short: "Query the total supply of every denom, or of a single denom",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			params := bank.QuerySupplyParams{}
+			if len(args) == 1 {
+				params.Denom = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/supply", queryRoute), bz)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryDenoms implements the query registered denoms command.
+func GetCmdQueryDenoms(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "denoms",
+		Short: "Query the metadata of the registered denoms",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denoms", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
+
+// GetCmdQueryParams implements the query bank params command.
+func GetCmdQueryParams(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "bank-params",
+		Short: "Query the current bank parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/parameters", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+}
@@ -19,6 +19,8 @@ import (
 const (
 	flagTo     = "to"
 	flagAmount = "amount"
+
+	queryRoute = "bank"
 )
 
 // SendTxCmd will create a send tx and sign it with the given key.
@@ -46,7 +48,7 @@ func SendTxCmd(cdc *wire.Codec) *cobra.Command {
 
 			// parse coins trying to be sent
 			amount := viper.GetString(flagAmount)
-			coins, err := sdk.ParseCoins(amount)
+			coins, err := client.ParseCoins(cliCtx, queryRoute, amount)
 			if err != nil {
 				return err
 			}
@@ -74,7 +76,7 @@ func SendTxCmd(cdc *wire.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().String(flagTo, "", "Address to send coins")
-	cmd.Flags().String(flagAmount, "", "Amount of coins to send")
+	cmd.Flags().String(flagAmount, "", "Amount of coins to send, in base or display units like 1.5atom")
 
 	return cmd
 }
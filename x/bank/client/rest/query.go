@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/gorilla/mux"
+)
+
+const queryRoute = "bank"
+
+// query balances REST Handler, of a single denom if the route has one
+func QueryBalancesRequestHandlerFn(queryRoute string, cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		addr, err := sdk.AccAddressFromBech32(vars["address"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		var route string
+		var params interface{}
+		denom, ok := vars["denom"]
+		if ok {
+			route = "balance"
+			params = bank.QueryBalanceParams{
+				Address: addr,
+				Denom:   denom,
+			}
+		} else {
+			route = "balances"
+			params = bank.QueryBalancesParams{
+				Address: addr,
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, route), bz)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query balances. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// query total supply REST Handler, of a single denom if the route has one
+func QuerySupplyRequestHandlerFn(queryRoute string, cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		params := bank.QuerySupplyParams{
+			Denom: mux.Vars(r)["denom"],
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/supply", queryRoute), bz)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query total supply. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// query registered denoms REST Handler
+func QueryDenomsRequestHandlerFn(queryRoute string, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denoms", queryRoute), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query denoms. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// query bank params REST Handler
+func QueryParamsRequestHandlerFn(queryRoute string, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/parameters", queryRoute), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query bank params. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
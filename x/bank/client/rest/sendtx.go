@@ -19,6 +19,12 @@ import (
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
 	r.HandleFunc("/accounts/{address}/send", SendRequestHandlerFn(cdc, kb, cliCtx)).Methods("POST")
+	r.HandleFunc("/bank/balances/{address}", QueryBalancesRequestHandlerFn(queryRoute, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/balances/{address}/{denom}", QueryBalancesRequestHandlerFn(queryRoute, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/supply", QuerySupplyRequestHandlerFn(queryRoute, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/supply/{denom}", QuerySupplyRequestHandlerFn(queryRoute, cdc, cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/denoms", QueryDenomsRequestHandlerFn(queryRoute, cliCtx)).Methods("GET")
+	r.HandleFunc("/bank/parameters", QueryParamsRequestHandlerFn(queryRoute, cliCtx)).Methods("GET")
 }
 
 type sendBody struct {
@@ -31,6 +37,7 @@ type sendBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	TimeoutHeight    int64     `json:"timeout_height"`
 }
 
 var msgCdc = wire.NewCodec()
@@ -80,6 +87,7 @@ func SendRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLICo
 		txCtx := authctx.TxContext{
 			Codec:         cdc,
 			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
 			ChainID:       m.ChainID,
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
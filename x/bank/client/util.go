@@ -1,6 +1,9 @@
 package client
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	bank "github.com/cosmos/cosmos-sdk/x/bank"
 )
@@ -12,3 +15,21 @@ func BuildMsg(from sdk.AccAddress, to sdk.AccAddress, coins sdk.Coins) sdk.Msg {
 	msg := bank.NewMsgSend([]bank.Input{input}, []bank.Output{output})
 	return msg
 }
+
+// ParseCoins parses a list of coins, converting the amounts in a unit of the
+// denoms registered on chain, like "1.5atom", to their base denom. It fails if
+// the denoms can not be queried.
+func ParseCoins(cliCtx context.CLIContext, queryRoute string, coinsStr string) (sdk.Coins, error) {
+	res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/denoms", queryRoute), nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not query the denom metadata: %v", err)
+	}
+
+	var metadata []sdk.DenomMetadata
+	err = cliCtx.Codec.UnmarshalJSON(res, &metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	return sdk.ParseCoinsWithMetadata(coinsStr, metadata)
+}
@@ -2,6 +2,8 @@
 package bank
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -9,8 +11,13 @@ import (
 const (
 	DefaultCodespace sdk.CodespaceType = 2
 
-	CodeInvalidInput  sdk.CodeType = 101
-	CodeInvalidOutput sdk.CodeType = 102
+	CodeInvalidInput         sdk.CodeType = 101
+	CodeInvalidOutput        sdk.CodeType = 102
+	CodeInvalidModuleAccount sdk.CodeType = 103
+	CodeInvalidIssuance      sdk.CodeType = 104
+	CodeNotDenomOwner        sdk.CodeType = 105
+	CodeMaxSupplyExceeded    sdk.CodeType = 106
+	CodeSendDisabled         sdk.CodeType = 107
 )
 
 // NOTE: Don't stringer this, we'll put better messages in later.
@@ -20,6 +27,16 @@ func codeToDefaultMsg(code sdk.CodeType) string {
 		return "invalid input coins"
 	case CodeInvalidOutput:
 		return "invalid output coins"
+	case CodeInvalidModuleAccount:
+		return "invalid module account"
+	case CodeInvalidIssuance:
+		return "invalid issuance"
+	case CodeNotDenomOwner:
+		return "not the owner of the denom"
+	case CodeMaxSupplyExceeded:
+		return "max supply exceeded"
+	case CodeSendDisabled:
+		return "send transactions are disabled"
 	default:
 		return sdk.CodeToDefaultMsg(code)
 	}
@@ -44,6 +61,54 @@ func ErrNoOutputs(codespace sdk.CodespaceType) sdk.Error {
 	return newError(codespace, CodeInvalidOutput, "")
 }
 
+func ErrSendToModuleAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return newError(codespace, CodeInvalidOutput, fmt.Sprintf("cannot send coins to module account %s", addr))
+}
+
+func ErrUnknownModuleAccount(codespace sdk.CodespaceType, name string) sdk.Error {
+	return newError(codespace, CodeInvalidModuleAccount, fmt.Sprintf("module %s has no module account", name))
+}
+
+func ErrModulePermission(codespace sdk.CodespaceType, name, permission string) sdk.Error {
+	return newError(codespace, CodeInvalidModuleAccount, fmt.Sprintf("module account of %s does not have the %s permission", name, permission))
+}
+
+func ErrIssuanceDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, "the bank keeper does not record issuances")
+}
+
+func ErrIssueBondDenom(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, fmt.Sprintf("cannot issue the bond denom %s", denom))
+}
+
+func ErrDenomExists(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, fmt.Sprintf("denom %s already exists and was not issued", denom))
+}
+
+func ErrMaxSupplyChange(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, fmt.Sprintf("the max supply of %s can only be set when it is first issued", denom))
+}
+
+func ErrMaxSupplyNotIssued(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, fmt.Sprintf("max supply of %s which is not issued", denom))
+}
+
+func ErrUnknownIssuance(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeInvalidIssuance, fmt.Sprintf("denom %s was not issued", denom))
+}
+
+func ErrNotDenomOwner(codespace sdk.CodespaceType, addr sdk.AccAddress, denom string) sdk.Error {
+	return newError(codespace, CodeNotDenomOwner, fmt.Sprintf("%s is not the owner of %s", addr, denom))
+}
+
+func ErrMaxSupplyExceeded(codespace sdk.CodespaceType, denom string, maxSupply sdk.Int) sdk.Error {
+	return newError(codespace, CodeMaxSupplyExceeded, fmt.Sprintf("issuing %s exceeds its max supply of %v", denom, maxSupply))
+}
+
+func ErrSendDisabled(codespace sdk.CodespaceType, denom string) sdk.Error {
+	return newError(codespace, CodeSendDisabled, fmt.Sprintf("%s transfers are currently disabled", denom))
+}
+
 //----------------------------------------
 
 func msgOrDefaultMsg(msg string, code sdk.CodeType) string {
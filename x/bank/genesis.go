@@ -0,0 +1,83 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all bank state that must be provided at genesis
+type GenesisState struct {
+	Params        Params              `json:"params"`
+	DenomMetadata []sdk.DenomMetadata `json:"denom_metadata"`
+	Supply        sdk.Coins           `json:"supply"`
+	Issuances     []Issuance          `json:"issuances"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, metadata []sdk.DenomMetadata, supply sdk.Coins, issuances []Issuance) GenesisState {
+	return GenesisState{
+		Params:        params,
+		DenomMetadata: metadata,
+		Supply:        supply,
+		Issuances:     issuances,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+		Supply: sdk.Coins{},
+	}
+}
+
+// InitGenesis sets the params, the denom metadata and the total supply for
+// genesis
+func InitGenesis(ctx sdk.Context, sk SupplyKeeper, pk ParamsKeeper, data GenesisState) {
+	err := ValidateGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+	pk.SetParams(ctx, data.Params)
+	pk.SetDenomMetadata(ctx, data.DenomMetadata)
+	sk.SetSupply(ctx, data.Supply)
+	for _, issuance := range data.Issuances {
+		sk.SetIssuance(ctx, issuance)
+	}
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper. The
+// GenesisState will contain the params, the denom metadata, the total supply
+// and the issuances.
+func WriteGenesis(ctx sdk.Context, sk SupplyKeeper, pk ParamsKeeper) GenesisState {
+	var issuances []Issuance
+	sk.IterateIssuances(ctx, func(issuance Issuance) (stop bool) {
+		issuances = append(issuances, issuance)
+		return false
+	})
+	return NewGenesisState(pk.GetParams(ctx), pk.GetDenomMetadata(ctx), sk.GetSupply(ctx), issuances)
+}
+
+// ValidateGenesis performs basic validation of bank genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	err := data.Params.Validate()
+	if err != nil {
+		return err
+	}
+	err = ValidateDenomMetadata(data.DenomMetadata)
+	if err != nil {
+		return err
+	}
+	if !data.Supply.IsValid() || !data.Supply.IsNotNegative() {
+		return fmt.Errorf("invalid total supply: %v", data.Supply)
+	}
+	for _, issuance := range data.Issuances {
+		err = issuance.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -14,6 +14,8 @@ func NewHandler(k Keeper) sdk.Handler {
 			return handleMsgSend(ctx, k, msg)
 		case MsgIssue:
 			return handleMsgIssue(ctx, k, msg)
+		case MsgTransferOwnership:
+			return handleMsgTransferOwnership(ctx, k, msg)
 		default:
 			errMsg := "Unrecognized bank Msg type: " + reflect.TypeOf(msg).Name()
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -25,6 +27,13 @@ func NewHandler(k Keeper) sdk.Handler {
 func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 	// NOTE: totalIn == totalOut should already have been checked
 
+	// module accounts only receive coins through their module
+	for _, out := range msg.Outputs {
+		if k.IsModuleAccount(ctx, out.Address) {
+			return ErrSendToModuleAccount(DefaultCodespace, out.Address).Result()
+		}
+	}
+
 	tags, err := k.InputOutputCoins(ctx, msg.Inputs, msg.Outputs)
 	if err != nil {
 		return err.Result()
@@ -37,5 +46,29 @@ func handleMsgSend(ctx sdk.Context, k Keeper, msg MsgSend) sdk.Result {
 
 // Handle MsgIssue.
 func handleMsgIssue(ctx sdk.Context, k Keeper, msg MsgIssue) sdk.Result {
-	panic("not implemented yet")
+	// module accounts only receive coins through their module
+	for _, out := range msg.Outputs {
+		if k.IsModuleAccount(ctx, out.Address) {
+			return ErrSendToModuleAccount(DefaultCodespace, out.Address).Result()
+		}
+	}
+
+	tags, err := k.IssueCoins(ctx, msg.Banker, msg.Outputs, msg.MaxSupply)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+// Handle MsgTransferOwnership.
+func handleMsgTransferOwnership(ctx sdk.Context, k Keeper, msg MsgTransferOwnership) sdk.Result {
+	err := k.TransferOwnership(ctx, msg.Owner, msg.NewOwner, msg.Denom)
+	if err != nil {
+		return err.Result()
+	}
+
+	return sdk.Result{}
 }
@@ -0,0 +1,86 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// key prefix for the issuances in the bank store
+var IssuanceKeyPrefix = []byte{0x01}
+
+// get the key for the issuance of a denom
+func GetIssuanceKey(denom string) []byte {
+	return append(IssuanceKeyPrefix, []byte(denom)...)
+}
+
+// Issuance records the owner of a denom created by MsgIssue, the only account
+// which may issue more of it, and its optional max supply
+type Issuance struct {
+	Denom     string         `json:"denom"`
+	Owner     sdk.AccAddress `json:"owner"`
+	MaxSupply sdk.Int        `json:"max_supply"` // zero if the supply is not capped
+}
+
+// NewIssuance returns a new Issuance
+func NewIssuance(denom string, owner sdk.AccAddress, maxSupply sdk.Int) Issuance {
+	return Issuance{
+		Denom:     denom,
+		Owner:     owner,
+		MaxSupply: maxSupply,
+	}
+}
+
+// whether the supply of the denom may grow to the amount
+func (i Issuance) allowsSupply(supply sdk.Int) bool {
+	return i.MaxSupply.IsZero() || !supply.GT(i.MaxSupply)
+}
+
+// validate the issuance
+func (i Issuance) Validate() error {
+	if len(i.Denom) == 0 {
+		return fmt.Errorf("issuance denom cannot be empty")
+	}
+	if len(i.Owner) == 0 {
+		return fmt.Errorf("issuance of %s has no owner", i.Denom)
+	}
+	if i.MaxSupply.LT(sdk.ZeroInt()) {
+		return fmt.Errorf("issuance of %s has a negative max supply %v", i.Denom, i.MaxSupply)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// GetIssuance returns the issuance of a denom
+func (sk SupplyKeeper) GetIssuance(ctx sdk.Context, denom string) (issuance Issuance, found bool) {
+	store := ctx.KVStore(sk.storeKey)
+	bz := store.Get(GetIssuanceKey(denom))
+	if bz == nil {
+		return issuance, false
+	}
+	sk.cdc.MustUnmarshalBinary(bz, &issuance)
+	return issuance, true
+}
+
+// SetIssuance sets the issuance of a denom
+func (sk SupplyKeeper) SetIssuance(ctx sdk.Context, issuance Issuance) {
+	store := ctx.KVStore(sk.storeKey)
+	bz := sk.cdc.MustMarshalBinary(issuance)
+	store.Set(GetIssuanceKey(issuance.Denom), bz)
+}
+
+// IterateIssuances iterates over the issuances of every denom created by
+// MsgIssue
+func (sk SupplyKeeper) IterateIssuances(ctx sdk.Context, fn func(issuance Issuance) (stop bool)) {
+	store := ctx.KVStore(sk.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, IssuanceKeyPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var issuance Issuance
+		sk.cdc.MustUnmarshalBinary(iter.Value(), &issuance)
+		if fn(issuance) {
+			break
+		}
+	}
+}
@@ -15,9 +15,19 @@ const (
 	costAddCoins      sdk.Gas = 10
 )
 
+// StakeKeeper expected by the bank keeper, which refuses to issue the bond
+// denom
+type StakeKeeper interface {
+	BondDenom(ctx sdk.Context) string
+}
+
 // Keeper manages transfers between accounts
 type Keeper struct {
-	am auth.AccountMapper
+	am          auth.AccountMapper
+	sk          *SupplyKeeper
+	pk          *ParamsKeeper
+	stakeKeeper StakeKeeper
+	moduleNames []string
 }
 
 // NewKeeper returns a new Keeper
@@ -25,6 +35,43 @@ func NewKeeper(am auth.AccountMapper) Keeper {
 	return Keeper{am: am}
 }
 
+// Set the supply keeper recording the coins minted and burned by the keeper
+func (keeper Keeper) WithSupplyKeeper(sk SupplyKeeper) Keeper {
+	if keeper.sk != nil {
+		panic("cannot set supply keeper twice")
+	}
+	keeper.sk = &sk
+	return keeper
+}
+
+// Set the params keeper providing the send enabled flags of the denoms
+func (keeper Keeper) WithParamsKeeper(pk ParamsKeeper) Keeper {
+	if keeper.pk != nil {
+		panic("cannot set params keeper twice")
+	}
+	keeper.pk = &pk
+	return keeper
+}
+
+// Set the stake keeper providing the bond denom, which can not be issued
+func (keeper Keeper) WithStakeKeeper(sk StakeKeeper) Keeper {
+	if keeper.stakeKeeper != nil {
+		panic("cannot set stake keeper twice")
+	}
+	keeper.stakeKeeper = sk
+	return keeper
+}
+
+// Set the names of the modules owning module accounts, their addresses are
+// module accounts even before the accounts are first used
+func (keeper Keeper) WithModuleAccounts(names ...string) Keeper {
+	if keeper.moduleNames != nil {
+		panic("cannot set module accounts twice")
+	}
+	keeper.moduleNames = names
+	return keeper
+}
+
 // GetCoins returns the coins at the addr.
 func (keeper Keeper) GetCoins(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins {
 	return getCoins(ctx, keeper.am, addr)
@@ -55,11 +102,188 @@ func (keeper Keeper) SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr
 	return sendCoins(ctx, keeper.am, fromAddr, toAddr, amt)
 }
 
-// InputOutputCoins handles a list of inputs and outputs
+// InputOutputCoins handles a list of inputs and outputs, all of whose denoms
+// must be send enabled
 func (keeper Keeper) InputOutputCoins(ctx sdk.Context, inputs []Input, outputs []Output) (sdk.Tags, sdk.Error) {
+	for _, in := range inputs {
+		if err := keeper.CheckSendEnabled(ctx, in.Coins); err != nil {
+			return nil, err
+		}
+	}
 	return inputOutputCoins(ctx, keeper.am, inputs, outputs)
 }
 
+// CheckSendEnabled returns an error if any of the denoms of amt can not be
+// transferred. Every denom can be transferred if the keeper has no params
+// keeper.
+func (keeper Keeper) CheckSendEnabled(ctx sdk.Context, amt sdk.Coins) sdk.Error {
+	if keeper.pk == nil {
+		return nil
+	}
+	params := keeper.pk.GetParams(ctx)
+	for _, coin := range amt {
+		if !params.IsSendEnabled(coin.Denom) {
+			return ErrSendDisabled(DefaultCodespace, coin.Denom)
+		}
+	}
+	return nil
+}
+
+// DelegateCoins removes amt from the coins at the addr for a delegation,
+// vesting coins may be delegated.
+func (keeper Keeper) DelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	return delegateCoins(ctx, keeper.am, addr, amt)
+}
+
+// UndelegateCoins returns amt to the coins at the addr from a delegation.
+func (keeper Keeper) UndelegateCoins(ctx sdk.Context, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	return undelegateCoins(ctx, keeper.am, addr, amt)
+}
+
+// GetModuleAccount returns the account of a module, creating it with the
+// given permission if it does not exist yet.
+func (keeper Keeper) GetModuleAccount(ctx sdk.Context, name, permission string) *auth.ModuleAccount {
+	return keeper.am.GetModuleAccount(ctx, name, permission)
+}
+
+// MintCoins adds newly minted amt to the coins of the account of a module
+// with the minter permission.
+func (keeper Keeper) MintCoins(ctx sdk.Context, name string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc, err := getModuleAccount(ctx, keeper.am, name, auth.Minter)
+	if err != nil {
+		return nil, err
+	}
+	_, tags, err := addCoins(ctx, keeper.am, macc.Address, amt)
+	if err != nil {
+		return nil, err
+	}
+	keeper.InflateSupply(ctx, amt)
+	return tags, nil
+}
+
+// BurnCoins removes amt from the coins of the account of a module with the
+// burner permission, destroying them.
+func (keeper Keeper) BurnCoins(ctx sdk.Context, name string, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	macc, err := getModuleAccount(ctx, keeper.am, name, auth.Burner)
+	if err != nil {
+		return nil, err
+	}
+	_, tags, err := subtractCoins(ctx, keeper.am, macc.Address, amt)
+	if err != nil {
+		return nil, err
+	}
+	keeper.DeflateSupply(ctx, amt)
+	return tags, nil
+}
+
+// IssueCoins issues new coins to the outputs. The issuer becomes the owner of
+// the denoms issued for the first time, capping their supply at the max
+// supply if it has one, and must be the owner of the denoms issued before.
+func (keeper Keeper) IssueCoins(ctx sdk.Context, issuer sdk.AccAddress, outputs []Output, maxSupply sdk.Coins) (sdk.Tags, sdk.Error) {
+	if keeper.sk == nil {
+		return nil, ErrIssuanceDisabled(DefaultCodespace)
+	}
+
+	var amt sdk.Coins
+	for _, out := range outputs {
+		amt = amt.Plus(out.Coins)
+	}
+
+	// a max supply may only be set for the issued denoms
+	for _, coin := range maxSupply {
+		if amt.AmountOf(coin.Denom).IsZero() {
+			return nil, ErrMaxSupplyNotIssued(DefaultCodespace, coin.Denom)
+		}
+	}
+
+	var issuances []Issuance
+	for _, coin := range amt {
+		supply := keeper.sk.GetTotalSupply(ctx, coin.Denom)
+		issuance, found := keeper.sk.GetIssuance(ctx, coin.Denom)
+		switch {
+		case !found && keeper.stakeKeeper != nil && coin.Denom == keeper.stakeKeeper.BondDenom(ctx):
+			return nil, ErrIssueBondDenom(DefaultCodespace, coin.Denom)
+		case !found && !supply.IsZero():
+			return nil, ErrDenomExists(DefaultCodespace, coin.Denom)
+		case !found:
+			issuance = NewIssuance(coin.Denom, issuer, maxSupply.AmountOf(coin.Denom))
+			issuances = append(issuances, issuance)
+		case !issuance.Owner.Equals(issuer):
+			return nil, ErrNotDenomOwner(DefaultCodespace, issuer, coin.Denom)
+		case !maxSupply.AmountOf(coin.Denom).IsZero():
+			return nil, ErrMaxSupplyChange(DefaultCodespace, coin.Denom)
+		}
+
+		if !issuance.allowsSupply(supply.Add(coin.Amount)) {
+			return nil, ErrMaxSupplyExceeded(DefaultCodespace, coin.Denom, issuance.MaxSupply)
+		}
+	}
+
+	allTags := sdk.EmptyTags()
+	for _, out := range outputs {
+		_, tags, err := addCoins(ctx, keeper.am, out.Address, out.Coins)
+		if err != nil {
+			return nil, err
+		}
+		allTags = allTags.AppendTags(tags)
+	}
+	for _, issuance := range issuances {
+		keeper.sk.SetIssuance(ctx, issuance)
+	}
+	keeper.sk.Inflate(ctx, amt)
+
+	return allTags, nil
+}
+
+// TransferOwnership transfers the ownership of an issued denom from its owner
+// to the new owner.
+func (keeper Keeper) TransferOwnership(ctx sdk.Context, owner, newOwner sdk.AccAddress, denom string) sdk.Error {
+	if keeper.sk == nil {
+		return ErrIssuanceDisabled(DefaultCodespace)
+	}
+
+	issuance, found := keeper.sk.GetIssuance(ctx, denom)
+	if !found {
+		return ErrUnknownIssuance(DefaultCodespace, denom)
+	}
+	if !issuance.Owner.Equals(owner) {
+		return ErrNotDenomOwner(DefaultCodespace, owner, denom)
+	}
+
+	issuance.Owner = newOwner
+	keeper.sk.SetIssuance(ctx, issuance)
+	return nil
+}
+
+// InflateSupply records coins minted outside of module accounts in the total
+// supply.
+func (keeper Keeper) InflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	if keeper.sk != nil {
+		keeper.sk.Inflate(ctx, amt)
+	}
+}
+
+// DeflateSupply records coins burned outside of module accounts, such as
+// slashed delegations, in the total supply.
+func (keeper Keeper) DeflateSupply(ctx sdk.Context, amt sdk.Coins) {
+	if keeper.sk != nil {
+		keeper.sk.Deflate(ctx, amt)
+	}
+}
+
+// IsModuleAccount returns whether the addr is the address of the account of
+// one of the modules set with WithModuleAccounts, whether or not the account
+// exists yet, or the account at the addr is a module account.
+func (keeper Keeper) IsModuleAccount(ctx sdk.Context, addr sdk.AccAddress) bool {
+	for _, name := range keeper.moduleNames {
+		if addr.Equals(auth.NewModuleAddress(name)) {
+			return true
+		}
+	}
+	_, ok := keeper.am.GetAccount(ctx, addr).(*auth.ModuleAccount)
+	return ok
+}
+
 //______________________________________________________________________________________________
 
 // SendKeeper only allows transfers between accounts, without the possibility of creating coins
@@ -140,6 +364,16 @@ func setCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 	return nil
 }
 
+// get the coins and the spendable coins at the addr
+func getSpendableCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress) (coins, spendableCoins sdk.Coins) {
+	ctx.GasMeter().ConsumeGas(costGetCoins, "getCoins")
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		return sdk.Coins{}, sdk.Coins{}
+	}
+	return acc.GetCoins(), auth.SpendableCoins(acc, ctx.BlockHeader().Time)
+}
+
 // HasCoins returns whether or not an account has at least amt coins.
 func hasCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) bool {
 	ctx.GasMeter().ConsumeGas(costHasCoins, "hasCoins")
@@ -147,13 +381,14 @@ func hasCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 }
 
 // SubtractCoins subtracts amt from the coins at the addr.
+// The coins of a vesting account which are still vesting can not be subtracted.
 func subtractCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Coins, sdk.Tags, sdk.Error) {
 	ctx.GasMeter().ConsumeGas(costSubtractCoins, "subtractCoins")
-	oldCoins := getCoins(ctx, am, addr)
-	newCoins := oldCoins.Minus(amt)
-	if !newCoins.IsNotNegative() {
-		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	oldCoins, spendableCoins := getSpendableCoins(ctx, am, addr)
+	if !spendableCoins.Minus(amt).IsNotNegative() {
+		return amt, nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", spendableCoins, amt))
 	}
+	newCoins := oldCoins.Minus(amt)
 	err := setCoins(ctx, am, addr, newCoins)
 	tags := sdk.NewTags("sender", []byte(addr.String()))
 	return newCoins, tags, err
@@ -172,6 +407,18 @@ func addCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt s
 	return newCoins, tags, err
 }
 
+// getModuleAccount returns the account of a module with the permission
+func getModuleAccount(ctx sdk.Context, am auth.AccountMapper, name, permission string) (*auth.ModuleAccount, sdk.Error) {
+	macc, ok := am.GetAccount(ctx, auth.NewModuleAddress(name)).(*auth.ModuleAccount)
+	if !ok {
+		return nil, ErrUnknownModuleAccount(DefaultCodespace, name)
+	}
+	if !macc.HasPermission(permission) {
+		return nil, ErrModulePermission(DefaultCodespace, name, permission)
+	}
+	return macc, nil
+}
+
 // SendCoins moves coins from one account to another
 // NOTE: Make sure to revert state changes from tx on error
 func sendCoins(ctx sdk.Context, am auth.AccountMapper, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
@@ -211,3 +458,60 @@ func inputOutputCoins(ctx sdk.Context, am auth.AccountMapper, inputs []Input, ou
 
 	return allTags, nil
 }
+
+// DelegateCoins removes amt from the coins at the addr for a delegation,
+// keeping track of the delegated vesting coins of a vesting account.
+func delegateCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costSubtractCoins, "delegateCoins")
+	acc := am.GetAccount(ctx, addr)
+	oldCoins := sdk.Coins{}
+	if acc != nil {
+		oldCoins = acc.GetCoins()
+	}
+	newCoins := oldCoins.Minus(amt)
+	if acc == nil || !newCoins.IsNotNegative() {
+		return nil, sdk.ErrInsufficientCoins(fmt.Sprintf("%s < %s", oldCoins, amt))
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	} else {
+		err := acc.SetCoins(newCoins)
+		if err != nil {
+			// Handle w/ #870
+			panic(err)
+		}
+	}
+	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
+	am.SetAccount(ctx, acc)
+
+	return sdk.NewTags("sender", []byte(addr.String())), nil
+}
+
+// UndelegateCoins returns amt to the coins at the addr from a delegation,
+// keeping track of the delegated vesting coins of a vesting account.
+func undelegateCoins(ctx sdk.Context, am auth.AccountMapper, addr sdk.AccAddress, amt sdk.Coins) (sdk.Tags, sdk.Error) {
+	ctx.GasMeter().ConsumeGas(costAddCoins, "undelegateCoins")
+	if amt.IsZero() {
+		// nothing is returned from a fully slashed delegation
+		return sdk.EmptyTags(), nil
+	}
+	acc := am.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = am.NewAccountWithAddress(ctx, addr)
+	}
+
+	if vacc, ok := acc.(auth.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+	} else {
+		err := acc.SetCoins(acc.GetCoins().Plus(amt))
+		if err != nil {
+			// Handle w/ #870
+			panic(err)
+		}
+	}
+	ctx.GasMeter().ConsumeGas(costSetCoins, "setCoins")
+	am.SetAccount(ctx, acc)
+
+	return sdk.NewTags("recipient", []byte(addr.String())), nil
+}
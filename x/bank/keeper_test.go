@@ -2,6 +2,7 @@ package bank
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -15,19 +16,24 @@ import (
 	wire "github.com/cosmos/cosmos-sdk/wire"
 
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
-func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey) {
+func setupMultiStore() (sdk.MultiStore, *sdk.KVStoreKey, *sdk.KVStoreKey, *sdk.KVStoreKey) {
 	db := dbm.NewMemDB()
 	authKey := sdk.NewKVStoreKey("authkey")
+	bankKey := sdk.NewKVStoreKey("bankkey")
+	paramsKey := sdk.NewKVStoreKey("params")
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(authKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(bankKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
-	return ms, authKey
+	return ms, authKey, bankKey, paramsKey
 }
 
 func TestKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, _, _ := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
@@ -112,7 +118,7 @@ func TestKeeper(t *testing.T) {
 }
 
 func TestSendKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, _, _ := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
@@ -180,8 +186,68 @@ func TestSendKeeper(t *testing.T) {
 
 }
 
+func TestVestingAccountKeeper(t *testing.T) {
+	ms, authKey, _, _ := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	now := time.Unix(0, 0).UTC()
+	endTime := now.Add(24 * time.Hour)
+	ctx := sdk.NewContext(ms, abci.Header{Time: now}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	coinKeeper := NewKeeper(accountMapper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	bacc := auth.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	accountMapper.SetAccount(ctx, auth.NewDelayedVestingAccount(&bacc, endTime))
+
+	// vesting coins can not be sent
+	_, err := coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.NotNil(t, err)
+	_, _, err = coinKeeper.SubtractCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.NotNil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 100)}))
+
+	// received coins can be sent
+	coinKeeper.AddCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.Nil(t, err)
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	require.NotNil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 110)}))
+
+	// vesting coins can be delegated
+	_, err = coinKeeper.DelegateCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 10)}))
+	vacc := accountMapper.GetAccount(ctx, addr).(auth.VestingAccount)
+	require.True(t, vacc.GetDelegatedVesting().IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 100)}))
+	_, err = coinKeeper.DelegateCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	require.NotNil(t, err)
+
+	// the received coins are still spendable
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.Nil(t, err)
+
+	// undelegated vesting coins are still vesting
+	_, err = coinKeeper.UndelegateCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 100)}))
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.NotNil(t, err)
+
+	// all coins can be sent once vested
+	ctx = ctx.WithBlockHeader(abci.Header{Time: endTime})
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 120)}))
+}
+
 func TestViewKeeper(t *testing.T) {
-	ms, authKey := setupMultiStore()
+	ms, authKey, _, _ := setupMultiStore()
 
 	cdc := wire.NewCodec()
 	auth.RegisterBaseAccount(cdc)
@@ -207,3 +273,183 @@ func TestViewKeeper(t *testing.T) {
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 15)}))
 	require.False(t, viewKeeper.HasCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("barcoin", 5)}))
 }
+
+func TestModuleAccounts(t *testing.T) {
+	ms, authKey, _, _ := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	coinKeeper := NewKeeper(accountMapper).WithModuleAccounts("minter", "burner", "holder")
+	handler := NewHandler(coinKeeper)
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	coins := sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}
+	coinKeeper.SetCoins(ctx, addr, coins)
+
+	// the addresses of the modules are module accounts before the accounts exist
+	holderAddr := auth.NewModuleAddress("holder")
+	require.Nil(t, accountMapper.GetAccount(ctx, holderAddr))
+	require.True(t, coinKeeper.IsModuleAccount(ctx, holderAddr))
+	msg := NewMsgSend([]Input{NewInput(addr, coins)}, []Output{NewOutput(holderAddr, coins)})
+	res := handler(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidOutput), res.Code)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(coins))
+	require.True(t, coinKeeper.GetCoins(ctx, holderAddr).IsZero())
+
+	// modules without an account can not mint or burn
+	_, err := coinKeeper.MintCoins(ctx, "minter", coins)
+	require.NotNil(t, err)
+
+	minterAcc := coinKeeper.GetModuleAccount(ctx, "minter", auth.Minter)
+	burnerAcc := coinKeeper.GetModuleAccount(ctx, "burner", auth.Burner)
+	require.True(t, coinKeeper.IsModuleAccount(ctx, minterAcc.GetAddress()))
+	require.False(t, coinKeeper.IsModuleAccount(ctx, addr))
+
+	// Test MintCoins
+	_, err = coinKeeper.MintCoins(ctx, "minter", coins)
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, minterAcc.GetAddress()).IsEqual(coins))
+	_, err = coinKeeper.MintCoins(ctx, "burner", coins)
+	require.NotNil(t, err)
+
+	// Test BurnCoins
+	_, err = coinKeeper.SendCoins(ctx, minterAcc.GetAddress(), burnerAcc.GetAddress(), coins)
+	require.Nil(t, err)
+	_, err = coinKeeper.BurnCoins(ctx, "minter", coins)
+	require.NotNil(t, err)
+	_, err = coinKeeper.BurnCoins(ctx, "burner", coins)
+	require.Nil(t, err)
+	require.True(t, coinKeeper.GetCoins(ctx, burnerAcc.GetAddress()).IsZero())
+
+	// module accounts do not receive coins sent by MsgSend
+	msg = NewMsgSend([]Input{NewInput(addr, coins)}, []Output{NewOutput(burnerAcc.GetAddress(), coins)})
+	res = handler(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidOutput), res.Code)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(coins))
+}
+
+func TestSupplyKeeper(t *testing.T) {
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, bankKey)
+	paramsKeeper := NewParamsKeeper(cdc, params.NewKeeper(cdc, paramsKey).Setter())
+	coinKeeper := NewKeeper(accountMapper).WithSupplyKeeper(supplyKeeper)
+
+	// Test genesis
+	supply := sdk.Coins{sdk.NewInt64Coin("barcoin", 20), sdk.NewInt64Coin("foocoin", 10)}
+	require.NotNil(t, ValidateGenesis(NewGenesisState(DefaultParams(), nil, sdk.Coins{sdk.NewInt64Coin("foocoin", -10)}, nil)))
+	InitGenesis(ctx, supplyKeeper, paramsKeeper, NewGenesisState(DefaultParams(), nil, supply, nil))
+	require.Equal(t, NewGenesisState(DefaultParams(), nil, supply, nil), WriteGenesis(ctx, supplyKeeper, paramsKeeper))
+	require.Equal(t, sdk.NewInt(10), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+	require.True(t, supplyKeeper.GetTotalSupply(ctx, "bazcoin").IsZero())
+
+	// minted coins are added to the supply
+	coinKeeper.GetModuleAccount(ctx, "minter", auth.Minter)
+	_, err := coinKeeper.MintCoins(ctx, "minter", sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(15), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+
+	// burned coins are removed from the supply
+	burnerAcc := coinKeeper.GetModuleAccount(ctx, "burner", auth.Burner)
+	coinKeeper.SendCoins(ctx, auth.NewModuleAddress("minter"), burnerAcc.GetAddress(), sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	_, err = coinKeeper.BurnCoins(ctx, "burner", sdk.Coins{sdk.NewInt64Coin("foocoin", 2)})
+	require.Nil(t, err)
+	require.Equal(t, sdk.NewInt(13), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+
+	// a failed burn leaves the supply unchanged
+	_, err = coinKeeper.BurnCoins(ctx, "burner", sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})
+	require.NotNil(t, err)
+	require.Equal(t, sdk.NewInt(13), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+
+	// coins burned outside of module accounts are removed from the supply
+	coinKeeper.DeflateSupply(ctx, sdk.Coins{sdk.NewInt64Coin("barcoin", 20)})
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("foocoin", 13)}, supplyKeeper.GetSupply(ctx))
+	require.Panics(t, func() { coinKeeper.DeflateSupply(ctx, sdk.Coins{sdk.NewInt64Coin("foocoin", 14)}) })
+}
+
+// stake keeper with a fixed bond denom
+type testStakeKeeper string
+
+func (sk testStakeKeeper) BondDenom(ctx sdk.Context) string { return string(sk) }
+
+func TestIssueCoins(t *testing.T) {
+	ms, authKey, bankKey, paramsKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	supplyKeeper := NewSupplyKeeper(cdc, bankKey)
+	paramsKeeper := NewParamsKeeper(cdc, params.NewKeeper(cdc, paramsKey).Setter())
+	coinKeeper := NewKeeper(accountMapper).WithSupplyKeeper(supplyKeeper).WithStakeKeeper(testStakeKeeper("steak")).WithModuleAccounts("holder")
+	handler := NewHandler(coinKeeper)
+
+	owner := sdk.AccAddress([]byte("owner"))
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	InitGenesis(ctx, supplyKeeper, paramsKeeper, NewGenesisState(DefaultParams(), nil, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)}, nil))
+
+	// the first issuer becomes the owner of the denom
+	msg := NewMsgIssue(owner, []Output{NewOutput(addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 10)})}, sdk.Coins{sdk.NewInt64Coin("foocoin", 15)})
+	res := handler(ctx, msg)
+	require.True(t, res.IsOK(), res.Log)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}))
+	require.Equal(t, sdk.NewInt(10), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+	issuance, found := supplyKeeper.GetIssuance(ctx, "foocoin")
+	require.True(t, found)
+	require.Equal(t, NewIssuance("foocoin", owner, sdk.NewInt(15)), issuance)
+
+	// only the owner can issue more, up to the max supply, which can not change
+	msg = NewMsgIssue(addr, []Output{NewOutput(addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})}, nil)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotDenomOwner), handler(ctx, msg).Code)
+	msg = NewMsgIssue(owner, []Output{NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 6)})}, nil)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeMaxSupplyExceeded), handler(ctx, msg).Code)
+	msg = NewMsgIssue(owner, []Output{NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})}, sdk.Coins{sdk.NewInt64Coin("foocoin", 20)})
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidIssuance), handler(ctx, msg).Code)
+	msg = NewMsgIssue(owner, []Output{NewOutput(addr2, sdk.Coins{sdk.NewInt64Coin("foocoin", 5)})}, nil)
+	require.True(t, handler(ctx, msg).IsOK())
+	require.Equal(t, sdk.NewInt(15), supplyKeeper.GetTotalSupply(ctx, "foocoin"))
+
+	// the bond denom and the denoms existing before can not be issued
+	msg = NewMsgIssue(owner, []Output{NewOutput(addr, sdk.Coins{sdk.NewInt64Coin("steak", 10)})}, nil)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidIssuance), handler(ctx, msg).Code)
+	msg = NewMsgIssue(owner, []Output{NewOutput(addr, sdk.Coins{sdk.NewInt64Coin("barcoin", 10)})}, nil)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidIssuance), handler(ctx, msg).Code)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).IsEqual(sdk.Coins{sdk.NewInt64Coin("foocoin", 10)}))
+
+	// coins can not be issued to module accounts, even before they exist
+	msg = NewMsgIssue(owner, []Output{NewOutput(auth.NewModuleAddress("holder"), sdk.Coins{sdk.NewInt64Coin("foocoin", 1)})}, nil)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidOutput), handler(ctx, msg).Code)
+	require.True(t, coinKeeper.GetCoins(ctx, auth.NewModuleAddress("holder")).IsZero())
+
+	// the ownership is transferred to the new owner
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeNotDenomOwner), handler(ctx, NewMsgTransferOwnership(addr, addr2, "foocoin")).Code)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidIssuance), handler(ctx, NewMsgTransferOwnership(owner, addr2, "bazcoin")).Code)
+	require.True(t, handler(ctx, NewMsgTransferOwnership(owner, addr2, "foocoin")).IsOK())
+	issuance, _ = supplyKeeper.GetIssuance(ctx, "foocoin")
+	require.Equal(t, addr2, issuance.Owner)
+
+	// the issuances round trip through genesis
+	require.Equal(t, []Issuance{issuance}, WriteGenesis(ctx, supplyKeeper, paramsKeeper).Issuances)
+
+	// a max supply of a denom which is not issued is rejected by the keeper
+	_, err := coinKeeper.IssueCoins(ctx, owner, []Output{NewOutput(addr, sdk.Coins{sdk.NewInt64Coin("bazcoin", 10)})},
+		sdk.Coins{sdk.NewInt64Coin("quxcoin", 10)})
+	require.Equal(t, CodeInvalidIssuance, err.Code())
+	_, found = supplyKeeper.GetIssuance(ctx, "bazcoin")
+	require.False(t, found)
+	require.True(t, coinKeeper.GetCoins(ctx, addr).AmountOf("bazcoin").IsZero())
+
+	// coins can not be issued without a supply keeper
+	_, err = NewKeeper(accountMapper).IssueCoins(ctx, owner, msg.Outputs, nil)
+	require.Equal(t, CodeInvalidIssuance, err.Code())
+}
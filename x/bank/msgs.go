@@ -87,17 +87,20 @@ func (msg MsgSend) GetSigners() []sdk.AccAddress {
 //----------------------------------------
 // MsgIssue
 
-// MsgIssue - high level transaction of the coin module
+// MsgIssue - high level transaction of the coin module, issuing new coins to
+// the outputs. The first issuer of a denom becomes its owner, and may cap its
+// supply when it first issues it.
 type MsgIssue struct {
-	Banker  sdk.AccAddress `json:"banker"`
-	Outputs []Output       `json:"outputs"`
+	Banker    sdk.AccAddress `json:"banker"`
+	Outputs   []Output       `json:"outputs"`
+	MaxSupply sdk.Coins      `json:"max_supply"` // max supply of the denoms first issued
 }
 
 var _ sdk.Msg = MsgIssue{}
 
-// NewMsgIssue - construct arbitrary multi-in, multi-out send msg.
-func NewMsgIssue(banker sdk.AccAddress, out []Output) MsgIssue {
-	return MsgIssue{Banker: banker, Outputs: out}
+// NewMsgIssue - construct an issue msg, the max supply may be nil.
+func NewMsgIssue(banker sdk.AccAddress, out []Output, maxSupply sdk.Coins) MsgIssue {
+	return MsgIssue{Banker: banker, Outputs: out, MaxSupply: maxSupply}
 }
 
 // Implements Msg.
@@ -105,14 +108,30 @@ func (msg MsgIssue) Type() string { return "bank" } // TODO: "bank/issue"
 
 // Implements Msg.
 func (msg MsgIssue) ValidateBasic() sdk.Error {
-	// XXX
+	if len(msg.Banker) == 0 {
+		return sdk.ErrInvalidAddress(msg.Banker.String())
+	}
 	if len(msg.Outputs) == 0 {
 		return ErrNoOutputs(DefaultCodespace).TraceSDK("")
 	}
+	var totalOut sdk.Coins
 	for _, out := range msg.Outputs {
 		if err := out.ValidateBasic(); err != nil {
 			return err.TraceSDK("")
 		}
+		totalOut = totalOut.Plus(out.Coins)
+	}
+	if len(msg.MaxSupply) == 0 {
+		return nil
+	}
+	if !msg.MaxSupply.IsValid() || !msg.MaxSupply.IsPositive() {
+		return sdk.ErrInvalidCoins(msg.MaxSupply.String()).TraceSDK("invalid max supply")
+	}
+	// a max supply may only be set for the issued denoms
+	for _, coin := range msg.MaxSupply {
+		if totalOut.AmountOf(coin.Denom).IsZero() {
+			return sdk.ErrInvalidCoins(msg.MaxSupply.String()).TraceSDK("max supply of a denom which is not issued")
+		}
 	}
 	return nil
 }
@@ -124,11 +143,13 @@ func (msg MsgIssue) GetSignBytes() []byte {
 		outputs = append(outputs, output.GetSignBytes())
 	}
 	b, err := msgCdc.MarshalJSON(struct {
-		Banker  sdk.AccAddress    `json:"banker"`
-		Outputs []json.RawMessage `json:"outputs"`
+		Banker    sdk.AccAddress    `json:"banker"`
+		Outputs   []json.RawMessage `json:"outputs"`
+		MaxSupply sdk.Coins         `json:"max_supply,omitempty"`
 	}{
-		Banker:  msg.Banker,
-		Outputs: outputs,
+		Banker:    msg.Banker,
+		Outputs:   outputs,
+		MaxSupply: msg.MaxSupply,
 	})
 	if err != nil {
 		panic(err)
@@ -141,6 +162,55 @@ func (msg MsgIssue) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Banker}
 }
 
+//----------------------------------------
+// MsgTransferOwnership
+
+// MsgTransferOwnership - transfers the ownership of an issued denom, with the
+// right to issue more of it, to a new owner
+type MsgTransferOwnership struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+	Denom    string         `json:"denom"`
+}
+
+var _ sdk.Msg = MsgTransferOwnership{}
+
+// NewMsgTransferOwnership - construct a transfer ownership msg.
+func NewMsgTransferOwnership(owner, newOwner sdk.AccAddress, denom string) MsgTransferOwnership {
+	return MsgTransferOwnership{Owner: owner, NewOwner: newOwner, Denom: denom}
+}
+
+// Implements Msg.
+func (msg MsgTransferOwnership) Type() string { return "bank" }
+
+// Implements Msg.
+func (msg MsgTransferOwnership) ValidateBasic() sdk.Error {
+	if len(msg.Owner) == 0 {
+		return sdk.ErrInvalidAddress(msg.Owner.String())
+	}
+	if len(msg.NewOwner) == 0 {
+		return sdk.ErrInvalidAddress(msg.NewOwner.String())
+	}
+	if len(msg.Denom) == 0 {
+		return sdk.ErrInvalidCoins("denom cannot be empty")
+	}
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgTransferOwnership) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgTransferOwnership) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 //----------------------------------------
 // Input
 
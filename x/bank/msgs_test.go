@@ -245,7 +245,37 @@ func TestMsgIssueType(t *testing.T) {
 }
 
 func TestMsgIssueValidation(t *testing.T) {
-	// TODO
+	banker := sdk.AccAddress([]byte{1, 2})
+	addr := sdk.AccAddress([]byte{7, 8})
+	atom123 := sdk.Coins{sdk.NewInt64Coin("atom", 123)}
+	eth123 := sdk.Coins{sdk.NewInt64Coin("eth", 123)}
+
+	var emptyAddr sdk.AccAddress
+
+	cases := []struct {
+		valid bool
+		msg   MsgIssue
+	}{
+		{false, MsgIssue{}},                                               // no banker or output
+		{false, NewMsgIssue(banker, nil, nil)},                            // no output
+		{false, NewMsgIssue(emptyAddr, []Output{{addr, atom123}}, nil)},   // no banker
+		{false, NewMsgIssue(banker, []Output{{emptyAddr, atom123}}, nil)}, // invalid output
+		{false, NewMsgIssue(banker, []Output{{addr, atom123}}, eth123)},   // max supply of a denom not issued
+		{false, NewMsgIssue(banker, []Output{{addr, atom123}},
+			sdk.Coins{sdk.NewInt64Coin("atom", 0)})}, // invalid max supply
+
+		{true, NewMsgIssue(banker, []Output{{addr, atom123}}, nil)},
+		{true, NewMsgIssue(banker, []Output{{addr, atom123}, {addr, eth123}}, atom123)},
+	}
+
+	for i, tc := range cases {
+		err := tc.msg.ValidateBasic()
+		if tc.valid {
+			require.Nil(t, err, "%d: %+v", i, err)
+		} else {
+			require.NotNil(t, err, "%d", i)
+		}
+	}
 }
 
 func TestMsgIssueGetSignBytes(t *testing.T) {
@@ -268,3 +298,24 @@ func TestMsgIssueGetSigners(t *testing.T) {
 	res := msg.GetSigners()
 	require.Equal(t, fmt.Sprintf("%v", res), "[6F6E6C796F6E65]")
 }
+
+// ----------------------------------------
+// MsgTransferOwnership Tests
+
+func TestMsgTransferOwnershipValidation(t *testing.T) {
+	owner := sdk.AccAddress([]byte{1, 2})
+	newOwner := sdk.AccAddress([]byte{7, 8})
+
+	var emptyAddr sdk.AccAddress
+
+	require.Nil(t, NewMsgTransferOwnership(owner, newOwner, "atom").ValidateBasic())
+	require.NotNil(t, NewMsgTransferOwnership(emptyAddr, newOwner, "atom").ValidateBasic())
+	require.NotNil(t, NewMsgTransferOwnership(owner, emptyAddr, "atom").ValidateBasic())
+	require.NotNil(t, NewMsgTransferOwnership(owner, newOwner, "").ValidateBasic())
+}
+
+func TestMsgTransferOwnershipGetSigners(t *testing.T) {
+	msg := NewMsgTransferOwnership(sdk.AccAddress([]byte("onlyone")), sdk.AccAddress([]byte("other")), "atom")
+	res := msg.GetSigners()
+	require.Equal(t, fmt.Sprintf("%v", res), "[6F6E6C796F6E65]")
+}
@@ -0,0 +1,159 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// nolint
+const (
+	ParamStoreKeyParams        = "bank/params"
+	ParamStoreKeyDenomMetadata = "bank/denom_metadata"
+)
+
+// SendEnabled overrides the default send enabled flag for a denom
+type SendEnabled struct {
+	Denom   string `json:"denom"`
+	Enabled bool   `json:"enabled"`
+}
+
+// NewSendEnabled returns a new SendEnabled
+func NewSendEnabled(denom string, enabled bool) SendEnabled {
+	return SendEnabled{
+		Denom:   denom,
+		Enabled: enabled,
+	}
+}
+
+// bank parameters, which control the denoms that can be transferred
+type Params struct {
+	DefaultSendEnabled bool          `json:"default_send_enabled"` // whether the denoms without an override can be transferred
+	SendEnabled        []SendEnabled `json:"send_enabled"`         // per denom overrides of the default
+}
+
+// default bank module parameters
+func DefaultParams() Params {
+	return Params{
+		DefaultSendEnabled: true,
+	}
+}
+
+// validate the bank parameters
+func (p Params) Validate() error {
+	seen := make(map[string]bool)
+	for _, se := range p.SendEnabled {
+		if len(se.Denom) == 0 {
+			return fmt.Errorf("bank send enabled denom cannot be empty")
+		}
+		if seen[se.Denom] {
+			return fmt.Errorf("bank send enabled is duplicated for denom %s", se.Denom)
+		}
+		seen[se.Denom] = true
+	}
+	return nil
+}
+
+// IsSendEnabled returns whether the denom can be transferred
+func (p Params) IsSendEnabled(denom string) bool {
+	for _, se := range p.SendEnabled {
+		if se.Denom == denom {
+			return se.Enabled
+		}
+	}
+	return p.DefaultSendEnabled
+}
+
+// validate the metadata of the registered denoms, no unit of which can belong
+// to two of them
+func ValidateDenomMetadata(metadata []sdk.DenomMetadata) error {
+	units := make(map[string]string)
+	for _, m := range metadata {
+		err := m.Validate()
+		if err != nil {
+			return err
+		}
+		for _, unit := range m.DenomUnits {
+			if base, ok := units[unit.Denom]; ok {
+				return fmt.Errorf("unit %s belongs to both %s and %s", unit.Denom, base, m.Base)
+			}
+			units[unit.Denom] = m.Base
+		}
+	}
+	return nil
+}
+
+// ValidateParamsChange decodes and validates the bank parameters proposed by a
+// governance parameter change
+func ValidateParamsChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var params Params
+	err := cdc.UnmarshalJSON([]byte(value), &params)
+	if err != nil {
+		return nil, err
+	}
+	return &params, params.Validate()
+}
+
+// ValidateDenomMetadataChange decodes and validates the denom metadata
+// proposed by a governance parameter change
+func ValidateDenomMetadataChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var metadata []sdk.DenomMetadata
+	err := cdc.UnmarshalJSON([]byte(value), &metadata)
+	if err != nil {
+		return nil, err
+	}
+	return &metadata, ValidateDenomMetadata(metadata)
+}
+
+//______________________________________________________________________
+
+// ParamsKeeper gets and sets the bank parameters in the global param store
+type ParamsKeeper struct {
+	cdc *wire.Codec
+
+	// The reference to the ParamSetter to get and set Global Params
+	ps params.Setter
+}
+
+// NewParamsKeeper returns a new ParamsKeeper
+func NewParamsKeeper(cdc *wire.Codec, ps params.Setter) ParamsKeeper {
+	return ParamsKeeper{
+		cdc: cdc,
+		ps:  ps,
+	}
+}
+
+// GetParams returns the current bank parameters from the global param store,
+// falling back to the default parameters if none have been set
+func (pk ParamsKeeper) GetParams(ctx sdk.Context) Params {
+	var params Params
+	err := pk.ps.Get(ctx, ParamStoreKeyParams, &params)
+	if err != nil {
+		return DefaultParams()
+	}
+	return params
+}
+
+// SetParams stores the bank parameters in the global param store
+func (pk ParamsKeeper) SetParams(ctx sdk.Context, params Params) {
+	pk.ps.Set(ctx, ParamStoreKeyParams, &params)
+}
+
+// GetDenomMetadata returns the metadata of the registered denoms from the
+// global param store
+func (pk ParamsKeeper) GetDenomMetadata(ctx sdk.Context) []sdk.DenomMetadata {
+	var metadata []sdk.DenomMetadata
+	err := pk.ps.Get(ctx, ParamStoreKeyDenomMetadata, &metadata)
+	if err != nil {
+		return nil
+	}
+	return metadata
+}
+
+// SetDenomMetadata stores the metadata of the registered denoms in the global
+// param store
+func (pk ParamsKeeper) SetDenomMetadata(ctx sdk.Context, metadata []sdk.DenomMetadata) {
+	pk.ps.Set(ctx, ParamStoreKeyDenomMetadata, &metadata)
+}
@@ -0,0 +1,118 @@
+package bank
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func TestParamsValidate(t *testing.T) {
+	require.Nil(t, DefaultParams().Validate())
+
+	p := DefaultParams()
+	p.SendEnabled = []SendEnabled{NewSendEnabled("steak", false), NewSendEnabled("foocoin", true)}
+	require.Nil(t, p.Validate())
+
+	p.SendEnabled = []SendEnabled{NewSendEnabled("", false)}
+	require.NotNil(t, p.Validate())
+	p.SendEnabled = []SendEnabled{NewSendEnabled("steak", false), NewSendEnabled("steak", true)}
+	require.NotNil(t, p.Validate())
+}
+
+func TestParamsIsSendEnabled(t *testing.T) {
+	p := Params{
+		DefaultSendEnabled: true,
+		SendEnabled:        []SendEnabled{NewSendEnabled("steak", false)},
+	}
+	require.False(t, p.IsSendEnabled("steak"))
+	require.True(t, p.IsSendEnabled("foocoin"))
+
+	p = Params{
+		DefaultSendEnabled: false,
+		SendEnabled:        []SendEnabled{NewSendEnabled("foocoin", true)},
+	}
+	require.False(t, p.IsSendEnabled("steak"))
+	require.True(t, p.IsSendEnabled("foocoin"))
+}
+
+func TestSendEnabled(t *testing.T) {
+	ms, authKey, _, paramsKey := setupMultiStore()
+
+	cdc := wire.NewCodec()
+	auth.RegisterBaseAccount(cdc)
+
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+	accountMapper := auth.NewAccountMapper(cdc, authKey, auth.ProtoBaseAccount)
+	paramsKeeper := NewParamsKeeper(cdc, params.NewKeeper(cdc, paramsKey).Setter())
+	coinKeeper := NewKeeper(accountMapper).WithParamsKeeper(paramsKeeper)
+	handler := NewHandler(coinKeeper)
+
+	// the default params are returned before any are set
+	require.Equal(t, DefaultParams(), paramsKeeper.GetParams(ctx))
+
+	addr := sdk.AccAddress([]byte("addr1"))
+	addr2 := sdk.AccAddress([]byte("addr2"))
+	coinKeeper.SetCoins(ctx, addr, sdk.Coins{sdk.NewInt64Coin("foocoin", 10), sdk.NewInt64Coin("steak", 10)})
+
+	steak := sdk.Coins{sdk.NewInt64Coin("steak", 5)}
+	foo := sdk.Coins{sdk.NewInt64Coin("foocoin", 5)}
+	sendSteak := MsgSend{Inputs: []Input{NewInput(addr, steak)}, Outputs: []Output{NewOutput(addr2, steak)}}
+	sendFoo := MsgSend{Inputs: []Input{NewInput(addr, foo)}, Outputs: []Output{NewOutput(addr2, foo)}}
+
+	// the steak transfers are disabled while the other denoms are enabled
+	p := DefaultParams()
+	p.SendEnabled = []SendEnabled{NewSendEnabled("steak", false)}
+	paramsKeeper.SetParams(ctx, p)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSendDisabled), handler(ctx, sendSteak).Code)
+	require.True(t, handler(ctx, sendFoo).IsOK())
+	require.True(t, coinKeeper.GetCoins(ctx, addr2).IsEqual(foo))
+
+	// the transfers of every denom are disabled by default
+	p = Params{DefaultSendEnabled: false}
+	paramsKeeper.SetParams(ctx, p)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeSendDisabled), handler(ctx, sendFoo).Code)
+	_, err := coinKeeper.InputOutputCoins(ctx, sendFoo.Inputs, sendFoo.Outputs)
+	require.NotNil(t, err)
+
+	// modules can still move disabled denoms
+	_, err = coinKeeper.SendCoins(ctx, addr, addr2, steak)
+	require.Nil(t, err)
+
+	// the default params accept the transfers
+	paramsKeeper.SetParams(ctx, DefaultParams())
+	require.True(t, handler(ctx, sendSteak).IsOK())
+}
+
+func TestDenomMetadata(t *testing.T) {
+	ms, _, bankKey, paramsKey := setupMultiStore()
+	cdc := wire.NewCodec()
+	supplyKeeper := NewSupplyKeeper(cdc, bankKey)
+	paramsKeeper := NewParamsKeeper(cdc, params.NewKeeper(cdc, paramsKey).Setter())
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewNopLogger())
+
+	atom := sdk.NewDenomMetadata("uatom", "atom", []sdk.DenomUnit{sdk.NewDenomUnit("uatom", 0), sdk.NewDenomUnit("atom", 6)}, "")
+	steak := sdk.NewDenomMetadata("steak", "steak", []sdk.DenomUnit{sdk.NewDenomUnit("steak", 0)}, "")
+	require.Nil(t, ValidateDenomMetadata([]sdk.DenomMetadata{atom, steak}))
+
+	// a unit can not belong to two denoms
+	other := sdk.NewDenomMetadata("natom", "atom", []sdk.DenomUnit{sdk.NewDenomUnit("natom", 0), sdk.NewDenomUnit("atom", 9)}, "")
+	require.NotNil(t, ValidateDenomMetadata([]sdk.DenomMetadata{atom, other}))
+
+	// no denom is registered before any is set
+	require.Len(t, paramsKeeper.GetDenomMetadata(ctx), 0)
+
+	// the denom metadata round trips through genesis
+	genesis := NewGenesisState(DefaultParams(), []sdk.DenomMetadata{atom, steak}, sdk.Coins{}, nil)
+	InitGenesis(ctx, supplyKeeper, paramsKeeper, genesis)
+	require.Equal(t, []sdk.DenomMetadata{atom, steak}, paramsKeeper.GetDenomMetadata(ctx))
+	require.Panics(t, func() {
+		InitGenesis(ctx, supplyKeeper, paramsKeeper, NewGenesisState(DefaultParams(), []sdk.DenomMetadata{atom, other}, sdk.Coins{}, nil))
+	})
+}
@@ -0,0 +1,128 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case "balances":
+			return queryBalances(ctx, path[1:], req, keeper)
+		case "balance":
+			return queryBalance(ctx, path[1:], req, keeper)
+		case "supply":
+			return querySupply(ctx, path[1:], req, keeper)
+		case "denoms":
+			return queryDenoms(ctx, keeper)
+		case "parameters":
+			return queryParams(ctx, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown bank query endpoint")
+		}
+	}
+}
+
+// Params for query 'custom/bank/balances'
+type QueryBalancesParams struct {
+	Address sdk.AccAddress
+}
+
+func queryBalances(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryBalancesParams
+	err2 := msgCdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	coins := keeper.GetCoins(ctx, params.Address)
+	if coins == nil {
+		coins = sdk.Coins{}
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(msgCdc, coins)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/bank/balance'
+type QueryBalanceParams struct {
+	Address sdk.AccAddress
+	Denom   string
+}
+
+func queryBalance(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryBalanceParams
+	err2 := msgCdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	coins := keeper.GetCoins(ctx, params.Address)
+	bz, err2 := wire.MarshalJSONIndent(msgCdc, sdk.NewCoin(params.Denom, coins.AmountOf(params.Denom)))
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/bank/supply'
+type QuerySupplyParams struct {
+	Denom string // if empty, the total supply of every denom is returned
+}
+
+func querySupply(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QuerySupplyParams
+	err2 := msgCdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	if keeper.sk == nil {
+		return []byte{}, sdk.ErrUnknownRequest("the bank keeper does not record the total supply")
+	}
+	supply := keeper.sk.GetSupply(ctx)
+
+	var bz []byte
+	if len(params.Denom) == 0 {
+		bz, err2 = wire.MarshalJSONIndent(msgCdc, supply)
+	} else {
+		bz, err2 = wire.MarshalJSONIndent(msgCdc, sdk.NewCoin(params.Denom, supply.AmountOf(params.Denom)))
+	}
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryDenoms(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	metadata := []sdk.DenomMetadata{}
+	if keeper.pk != nil {
+		metadata = append(metadata, keeper.pk.GetDenomMetadata(ctx)...)
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(msgCdc, metadata)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	params := DefaultParams()
+	if keeper.pk != nil {
+		params = keeper.pk.GetParams(ctx)
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(msgCdc, params)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
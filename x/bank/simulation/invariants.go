@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -48,3 +49,28 @@ func TotalCoinsInvariant(mapper auth.AccountMapper, totalSupplyFn func() sdk.Coi
 		require.Equal(t, totalSupplyFn(), totalCoins, log)
 	}
 }
+
+// SupplyInvariant checks that the sum of the coins across all accounts,
+// module accounts included, plus the coins held by modules outside of accounts
+// equals the recorded total supply
+func SupplyInvariant(mapper auth.AccountMapper, sk bank.SupplyKeeper, moduleHoldingsFn func(ctx sdk.Context) sdk.Coins) simulation.Invariant {
+	return func(t *testing.T, app *baseapp.BaseApp, log string) {
+		ctx := app.NewContext(false, abci.Header{})
+		totalCoins := sdk.Coins{}
+
+		chkAccount := func(acc auth.Account) bool {
+			coins := acc.GetCoins()
+			totalCoins = totalCoins.Plus(coins)
+			return false
+		}
+
+		mapper.IterateAccounts(ctx, chkAccount)
+		if moduleHoldingsFn != nil {
+			totalCoins = totalCoins.Plus(moduleHoldingsFn(ctx))
+		}
+		require.True(t, sk.GetSupply(ctx).IsEqual(totalCoins),
+			fmt.Sprintf("total supply %v does not equal the sum of held coins %v\n%s",
+				sk.GetSupply(ctx), totalCoins, log),
+		)
+	}
+}
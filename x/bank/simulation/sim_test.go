@@ -5,12 +5,14 @@ import (
 	"math/rand"
 	"testing"
 
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/mock/simulation"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 func TestBankWithRandomMessages(t *testing.T) {
@@ -18,10 +20,21 @@ func TestBankWithRandomMessages(t *testing.T) {
 
 	bank.RegisterWire(mapp.Cdc)
 	mapper := mapp.AccountMapper
-	coinKeeper := bank.NewKeeper(mapper)
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyParams := sdk.NewKVStoreKey("params")
+	supplyKeeper := bank.NewSupplyKeeper(mapp.Cdc, keyBank)
+	paramsKeeper := bank.NewParamsKeeper(mapp.Cdc, params.NewKeeper(mapp.Cdc, keyParams).Setter())
+	coinKeeper := bank.NewKeeper(mapper).WithSupplyKeeper(supplyKeeper).WithParamsKeeper(paramsKeeper)
 	mapp.Router().AddRoute("bank", bank.NewHandler(coinKeeper))
 
-	err := mapp.CompleteSetup([]*sdk.KVStoreKey{})
+	// the genesis accounts hold the total supply
+	mapp.SetInitChainer(func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
+		res := mapp.InitChainer(ctx, req)
+		bank.InitGenesis(ctx, supplyKeeper, paramsKeeper, bank.NewGenesisState(bank.DefaultParams(), nil, mapp.TotalCoinsSupply, nil))
+		return res
+	})
+
+	err := mapp.CompleteSetup([]*sdk.KVStoreKey{keyBank, keyParams})
 	if err != nil {
 		panic(err)
 	}
@@ -40,6 +53,7 @@ func TestBankWithRandomMessages(t *testing.T) {
 		[]simulation.Invariant{
 			NonnegativeBalanceInvariant(mapper),
 			TotalCoinsInvariant(mapper, func() sdk.Coins { return mapp.TotalCoinsSupply }),
+			SupplyInvariant(mapper, supplyKeeper, nil),
 		},
 		30, 30,
 		false,
@@ -0,0 +1,63 @@
+package bank
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// key for the total supply in the bank store
+var SupplyKey = []byte{0x00}
+
+// SupplyKeeper records the total supply of each denom in the bank store
+type SupplyKeeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+}
+
+// NewSupplyKeeper returns a new SupplyKeeper
+func NewSupplyKeeper(cdc *wire.Codec, key sdk.StoreKey) SupplyKeeper {
+	return SupplyKeeper{
+		storeKey: key,
+		cdc:      cdc,
+	}
+}
+
+// GetSupply returns the total supply of every denom
+func (sk SupplyKeeper) GetSupply(ctx sdk.Context) sdk.Coins {
+	store := ctx.KVStore(sk.storeKey)
+	bz := store.Get(SupplyKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+	var supply sdk.Coins
+	sk.cdc.MustUnmarshalBinary(bz, &supply)
+	return supply
+}
+
+// SetSupply sets the total supply of every denom
+func (sk SupplyKeeper) SetSupply(ctx sdk.Context, supply sdk.Coins) {
+	store := ctx.KVStore(sk.storeKey)
+	bz := sk.cdc.MustMarshalBinary(supply)
+	store.Set(SupplyKey, bz)
+}
+
+// GetTotalSupply returns the total supply of a denom
+func (sk SupplyKeeper) GetTotalSupply(ctx sdk.Context, denom string) sdk.Int {
+	return sk.GetSupply(ctx).AmountOf(denom)
+}
+
+// Inflate adds newly minted coins to the total supply
+func (sk SupplyKeeper) Inflate(ctx sdk.Context, amt sdk.Coins) {
+	sk.SetSupply(ctx, sk.GetSupply(ctx).Plus(amt))
+}
+
+// Deflate removes burned coins from the total supply
+func (sk SupplyKeeper) Deflate(ctx sdk.Context, amt sdk.Coins) {
+	supply := sk.GetSupply(ctx).Minus(amt)
+	if !supply.IsNotNegative() {
+		panic(fmt.Sprintf("burned %v exceeds the total supply", amt))
+	}
+	sk.SetSupply(ctx, supply)
+}
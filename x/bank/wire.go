@@ -8,6 +8,7 @@ import (
 func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(MsgSend{}, "cosmos-sdk/Send", nil)
 	cdc.RegisterConcrete(MsgIssue{}, "cosmos-sdk/Issue", nil)
+	cdc.RegisterConcrete(MsgTransferOwnership{}, "cosmos-sdk/TransferOwnership", nil)
 }
 
 var msgCdc = wire.NewCodec()
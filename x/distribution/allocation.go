@@ -0,0 +1,116 @@
+package distribution
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// distribution begin block functionality, allocates the fees collected
+// during the previous block, which include the minted inflation provisions
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	k.AllocateFees(ctx)
+}
+
+// nolint
+var (
+	BaseProposerReward  = sdk.NewDecWithPrec(1, 2) // fraction of the rewards always paid to the block proposer
+	BonusProposerReward = sdk.NewDecWithPrec(4, 2) // maximum additional fraction paid for including precommits
+)
+
+// Allocate the collected fees, which include the minted inflation provisions,
+// to the bonded validators. The community tax is first sent to the community pool and the
+// block proposer receives a bonus which scales with the precommit power it
+// included, the remainder is split in proportion to the bonded tokens. The
+// commission of each validator is taken off the top of its portion, the rest
+// is recorded per delegator share, where it remains until withdrawn. The
+// collected fees are moved to the distribution module account, which holds
+// them until they are withdrawn.
+func (k Keeper) AllocateFees(ctx sdk.Context) {
+	collected := k.feeCollectionKeeper.GetCollectedFees(ctx)
+	if collected.IsZero() {
+		return
+	}
+	distrAcc := k.coinKeeper.GetModuleAccount(ctx, ModuleName, auth.Holder)
+	_, err := k.coinKeeper.SendCoins(ctx, auth.NewModuleAddress(auth.FeeCollectorName), distrAcc.GetAddress(), collected)
+	if err != nil {
+		panic(err)
+	}
+	rewards := sdk.NewDecCoins(collected)
+
+	// without any bonded power everything goes to the community pool
+	totalPower := k.stakeKeeper.TotalPower(ctx)
+	if !totalPower.GT(sdk.ZeroDec()) {
+		k.fundCommunityPool(ctx, rewards)
+		return
+	}
+
+	proposerAddr := ctx.BlockHeader().Proposer.Address
+	proposerReward := sdk.DecCoins{}
+	if k.isBondedValidator(ctx, proposerAddr) {
+		proposerReward = rewards.MulDec(ProposerRewardFraction(ctx))
+	}
+	communityFunding := rewards.MulDec(k.GetCommunityTax(ctx))
+	k.fundCommunityPool(ctx, communityFunding)
+	rewards = rewards.Minus(proposerReward).Minus(communityFunding)
+
+	k.stakeKeeper.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
+		shares := validator.GetDelegatorShares()
+		if shares.IsZero() {
+			return false
+		}
+
+		reward := rewards.MulDec(validator.GetPower().Quo(totalPower))
+		if bytes.Equal(validator.GetPubKey().Address(), proposerAddr) {
+			reward = reward.Plus(proposerReward)
+		}
+		commission := reward.MulDec(validator.GetCommission())
+		reward = reward.Minus(commission)
+
+		vdi := k.GetValidatorDistInfo(ctx, validator.GetOperator())
+		vdi.Commission = vdi.Commission.Plus(commission)
+		vdi.RewardsPerShare = vdi.RewardsPerShare.Plus(reward.QuoDec(shares))
+		k.SetValidatorDistInfo(ctx, vdi)
+		return false
+	})
+}
+
+// add coins to the community pool
+func (k Keeper) fundCommunityPool(ctx sdk.Context, funds sdk.DecCoins) {
+	feePool := k.GetFeePool(ctx)
+	feePool.CommunityPool = feePool.CommunityPool.Plus(funds)
+	k.SetFeePool(ctx, feePool)
+}
+
+// The fraction of the rewards paid to the block proposer. It is
+// BaseProposerReward plus BonusProposerReward scaled by the fraction of the
+// previous validator set's voting power whose precommits were included.
+func ProposerRewardFraction(ctx sdk.Context) sdk.Dec {
+	precommitPower, totalPower := int64(0), int64(0)
+	for _, signingValidator := range ctx.SigningValidators() {
+		totalPower += signingValidator.Validator.Power
+		if signingValidator.SignedLastBlock {
+			precommitPower += signingValidator.Validator.Power
+		}
+	}
+	if totalPower == 0 {
+		return BaseProposerReward
+	}
+
+	bonus := BonusProposerReward.Mul(sdk.NewDec(precommitPower)).Quo(sdk.NewDec(totalPower))
+	return BaseProposerReward.Add(bonus)
+}
+
+// whether a bonded validator signs with the provided consensus address
+func (k Keeper) isBondedValidator(ctx sdk.Context, consensusAddr []byte) (found bool) {
+	if len(consensusAddr) == 0 {
+		return false
+	}
+	k.stakeKeeper.IterateValidatorsBonded(ctx, func(_ int64, validator sdk.Validator) (stop bool) {
+		found = bytes.Equal(validator.GetPubKey().Address(), consensusAddr) &&
+			!validator.GetDelegatorShares().IsZero()
+		return found
+	})
+	return found
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+)
+
+// GetCmdSetWithdrawAddr implements the set withdraw address command.
+func GetCmdSetWithdrawAddr(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-withdraw-addr [withdraw-addr]",
+		Short: "change the address the rewards of the sender are withdrawn to",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			withdrawAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgSetWithdrawAddress(delegatorAddr, withdrawAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdWithdrawRewards implements the withdraw delegator rewards command.
+func GetCmdWithdrawRewards(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards",
+		Short: "withdraw the rewards accrued by a delegation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			delegatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			validatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressValidator))
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgWithdrawDelegatorReward(delegatorAddr, validatorAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagAddressValidator, "", "bech address of the validator to withdraw the rewards from")
+
+	return cmd
+}
+
+// GetCmdWithdrawCommission implements the withdraw validator commission command.
+func GetCmdWithdrawCommission(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-commission",
+		Short: "withdraw the commission accrued by the validator operated by the sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			validatorAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			msg := distribution.NewMsgWithdrawValidatorCommission(validatorAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/gorilla/mux"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/rewards",
+		rewardsHandlerFn(cliCtx, "distr", cdc),
+	).Methods("GET")
+	r.HandleFunc(
+		"/stake/validators/{validatorAddr}/commission",
+		commissionHandlerFn(cliCtx, "distr", cdc),
+	).Methods("GET")
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/withdraw_address",
+		withdrawAddrHandlerFn(cliCtx, "distr", cdc),
+	).Methods("GET")
+	r.HandleFunc(
+		"/distribution/community-pool",
+		communityPoolHandlerFn(cliCtx, "distr"),
+	).Methods("GET")
+}
+
+// http request handler to query the outstanding rewards of a delegator, an
+// optional validator query parameter restricts them to a single delegation
+func rewardsHandlerFn(cliCtx context.CLIContext, queryRoute string, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(vars["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distribution.QueryRewardsParams{
+			DelegatorAddr: delegatorAddr,
+		}
+
+		bechValidatorAddr := r.URL.Query().Get("validator")
+		if len(bechValidatorAddr) != 0 {
+			validatorAddr, err := sdk.AccAddressFromBech32(bechValidatorAddr)
+			if err != nil {
+				utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+				return
+			}
+			params.ValidatorAddr = validatorAddr
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/rewards", queryRoute), bz)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query rewards. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// http request handler to query the outstanding commission of a validator
+func commissionHandlerFn(cliCtx context.CLIContext, queryRoute string, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distribution.QueryCommissionParams{
+			ValidatorAddr: validatorAddr,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/commission", queryRoute), bz)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query commission. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// http request handler to query the address the rewards of a delegator are
+// withdrawn to
+func withdrawAddrHandlerFn(cliCtx context.CLIContext, queryRoute string, cdc *wire.Codec) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		params := distribution.QueryWithdrawAddrParams{
+			DelegatorAddr: delegatorAddr,
+		}
+
+		bz, err := cdc.MarshalJSON(params)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/withdraw_addr", queryRoute), bz)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query withdraw address. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
+
+// http request handler to query the coins held by the community pool
+func communityPoolHandlerFn(cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/community_pool", queryRoute), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query community pool. Error: %s", err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
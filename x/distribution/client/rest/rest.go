@@ -0,0 +1,15 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers distribution-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+	registerQueryRoutes(cliCtx, r, cdc)
+	registerTxRoutes(cliCtx, r, cdc, kb)
+}
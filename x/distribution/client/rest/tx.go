@@ -0,0 +1,300 @@
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/crypto/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	"github.com/gorilla/mux"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec, kb keys.Keybase) {
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/withdraw_address",
+		setWithdrawAddrRequestHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/stake/delegators/{delegatorAddr}/rewards",
+		withdrawRewardsRequestHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+	r.HandleFunc(
+		"/stake/validators/{validatorAddr}/commission",
+		withdrawCommissionRequestHandlerFn(cdc, kb, cliCtx),
+	).Methods("POST")
+}
+
+// Set withdraw address TX body
+type SetWithdrawAddrBody struct {
+	LocalAccountName string `json:"name"`
+	Password         string `json:"password"`
+	ChainID          string `json:"chain_id"`
+	AccountNumber    int64  `json:"account_number"`
+	Sequence         int64  `json:"sequence"`
+	Gas              int64  `json:"gas"`
+	TimeoutHeight    int64  `json:"timeout_height"`
+	WithdrawAddr     string `json:"withdraw_addr"`
+}
+
+// Withdraw rewards TX body
+type WithdrawRewardsBody struct {
+	LocalAccountName string `json:"name"`
+	Password         string `json:"password"`
+	ChainID          string `json:"chain_id"`
+	AccountNumber    int64  `json:"account_number"`
+	Sequence         int64  `json:"sequence"`
+	Gas              int64  `json:"gas"`
+	TimeoutHeight    int64  `json:"timeout_height"`
+	ValidatorAddr    string `json:"validator_addr"`
+}
+
+// Withdraw commission TX body
+type WithdrawCommissionBody struct {
+	LocalAccountName string `json:"name"`
+	Password         string `json:"password"`
+	ChainID          string `json:"chain_id"`
+	AccountNumber    int64  `json:"account_number"`
+	Sequence         int64  `json:"sequence"`
+	Gas              int64  `json:"gas"`
+	TimeoutHeight    int64  `json:"timeout_height"`
+}
+
+func setWithdrawAddrRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m SetWithdrawAddrBody
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = json.Unmarshal(body, &m)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		info, err := kb.Get(m.LocalAccountName)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error()))
+			return
+		}
+
+		if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, "Must use own delegator address")
+			return
+		}
+
+		withdrawAddr, err := sdk.AccAddressFromBech32(m.WithdrawAddr)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, fmt.Sprintf("Couldn't decode withdraw address. Error: %s", err.Error()))
+			return
+		}
+
+		txCtx := authctx.TxContext{
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			AccountNumber: m.AccountNumber,
+			Sequence:      m.Sequence,
+			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		msg := distribution.NewMsgSetWithdrawAddress(delegatorAddr, withdrawAddr)
+
+		if m.Gas == 0 {
+			newCtx, err := utils.EnrichCtxWithGas(txCtx, cliCtx, m.LocalAccountName, m.Password, []sdk.Msg{msg})
+			if err != nil {
+				utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			txCtx = newCtx
+		}
+
+		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Write(output)
+	}
+}
+
+func withdrawRewardsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m WithdrawRewardsBody
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = json.Unmarshal(body, &m)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		info, err := kb.Get(m.LocalAccountName)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		delegatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["delegatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, fmt.Sprintf("Couldn't decode delegator. Error: %s", err.Error()))
+			return
+		}
+
+		if !bytes.Equal(info.GetPubKey().Address(), delegatorAddr) {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, "Must use own delegator address")
+			return
+		}
+
+		validatorAddr, err := sdk.AccAddressFromBech32(m.ValidatorAddr)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error()))
+			return
+		}
+
+		txCtx := authctx.TxContext{
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			AccountNumber: m.AccountNumber,
+			Sequence:      m.Sequence,
+			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		msg := distribution.NewMsgWithdrawDelegatorReward(delegatorAddr, validatorAddr)
+
+		if m.Gas == 0 {
+			newCtx, err := utils.EnrichCtxWithGas(txCtx, cliCtx, m.LocalAccountName, m.Password, []sdk.Msg{msg})
+			if err != nil {
+				utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			txCtx = newCtx
+		}
+
+		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Write(output)
+	}
+}
+
+func withdrawCommissionRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var m WithdrawCommissionBody
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+		err = json.Unmarshal(body, &m)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		info, err := kb.Get(m.LocalAccountName)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		validatorAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)["validatorAddr"])
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusBadRequest, fmt.Sprintf("Couldn't decode validator. Error: %s", err.Error()))
+			return
+		}
+
+		if !bytes.Equal(info.GetPubKey().Address(), validatorAddr) {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, "Must use own validator address")
+			return
+		}
+
+		txCtx := authctx.TxContext{
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			AccountNumber: m.AccountNumber,
+			Sequence:      m.Sequence,
+			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
+		}
+
+		msg := distribution.NewMsgWithdrawValidatorCommission(validatorAddr)
+
+		if m.Gas == 0 {
+			newCtx, err := utils.EnrichCtxWithGas(txCtx, cliCtx, m.LocalAccountName, m.Password, []sdk.Msg{msg})
+			if err != nil {
+				utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+				return
+			}
+			txCtx = newCtx
+		}
+
+		txBytes, err := txCtx.BuildAndSign(m.LocalAccountName, m.Password, []sdk.Msg{msg})
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		res, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		output, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Write(output)
+	}
+}
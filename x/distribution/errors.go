@@ -0,0 +1,45 @@
+//nolint
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodeType = sdk.CodeType
+
+const (
+	DefaultCodespace sdk.CodespaceType = 7
+
+	CodeInvalidInput     CodeType = 103
+	CodeNoDelegation     CodeType = 104
+	CodeInvalidDelegator CodeType = 105
+	CodeInvalidValidator CodeType = 106
+	CodeInvalidPoolSpend CodeType = 107
+)
+
+func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDelegator, "delegator address is nil")
+}
+func ErrNilWithdrawAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "withdraw address is nil")
+}
+func ErrWithdrawAddrModuleAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, fmt.Sprintf("withdraw address %s is a module account", addr))
+}
+func ErrNilValidatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidValidator, "validator address is nil")
+}
+func ErrNoDelegationForAddress(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation exists between that delegator and validator")
+}
+func ErrInvalidPoolSpendAmount(codespace sdk.CodespaceType, amount sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPoolSpend, fmt.Sprintf("invalid community pool spend amount %v", amount))
+}
+func ErrPoolSpendToModuleAccount(codespace sdk.CodespaceType, addr sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPoolSpend, fmt.Sprintf("cannot spend the community pool to module account %s", addr))
+}
+func ErrInsufficientCommunityPool(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPoolSpend, "community pool does not have sufficient coins to distribute")
+}
@@ -0,0 +1,78 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all distribution state that must be provided at genesis
+type GenesisState struct {
+	Params                 Params                  `json:"params"`
+	FeePool                FeePool                 `json:"fee_pool"`
+	ValidatorDistInfos     []ValidatorDistInfo     `json:"validator_dist_infos"`
+	DelegationDistInfos    []DelegationDistInfo    `json:"delegation_dist_infos"`
+	DelegatorWithdrawInfos []DelegatorWithdrawInfo `json:"delegator_withdraw_infos"`
+}
+
+func NewGenesisState(params Params, feePool FeePool, vdis []ValidatorDistInfo,
+	ddis []DelegationDistInfo, dwis []DelegatorWithdrawInfo) GenesisState {
+
+	return GenesisState{
+		Params:                 params,
+		FeePool:                feePool,
+		ValidatorDistInfos:     vdis,
+		DelegationDistInfos:    ddis,
+		DelegatorWithdrawInfos: dwis,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params:  DefaultParams(),
+		FeePool: InitialFeePool(),
+	}
+}
+
+// InitGenesis sets distribution information for genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	err := data.Params.Validate()
+	if err != nil {
+		panic(err)
+	}
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetFeePool(ctx, data.FeePool)
+	for _, vdi := range data.ValidatorDistInfos {
+		keeper.SetValidatorDistInfo(ctx, vdi)
+	}
+	for _, ddi := range data.DelegationDistInfos {
+		keeper.SetDelegationDistInfo(ctx, ddi)
+	}
+	for _, dwi := range data.DelegatorWithdrawInfos {
+		keeper.SetDelegatorWithdrawAddr(ctx, dwi.DelegatorAddr, dwi.WithdrawAddr)
+	}
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper. The
+// GenesisState will contain the parameters, the fee pool, the distribution
+// info of all validators and delegations and the withdraw addresses of all
+// delegators which have set one.
+func WriteGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	params := keeper.GetParams(ctx)
+	feePool := keeper.GetFeePool(ctx)
+	vdis := []ValidatorDistInfo{}
+	keeper.IterateValidatorDistInfos(ctx, func(_ int64, vdi ValidatorDistInfo) (stop bool) {
+		vdis = append(vdis, vdi)
+		return false
+	})
+	ddis := []DelegationDistInfo{}
+	keeper.IterateDelegationDistInfos(ctx, func(_ int64, ddi DelegationDistInfo) (stop bool) {
+		ddis = append(ddis, ddi)
+		return false
+	})
+	dwis := []DelegatorWithdrawInfo{}
+	keeper.IterateDelegatorWithdrawAddrs(ctx, func(_ int64, dwi DelegatorWithdrawInfo) (stop bool) {
+		dwis = append(dwis, dwi)
+		return false
+	})
+	return NewGenesisState(params, feePool, vdis, ddis, dwis)
+}
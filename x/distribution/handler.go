@@ -0,0 +1,75 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution/tags"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgSetWithdrawAddress:
+			return handleMsgSetWithdrawAddress(ctx, msg, k)
+		case MsgWithdrawDelegatorReward:
+			return handleMsgWithdrawDelegatorReward(ctx, msg, k)
+		case MsgWithdrawValidatorCommission:
+			return handleMsgWithdrawValidatorCommission(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in distribution module").Result()
+		}
+	}
+}
+
+//_____________________________________________________________________
+
+// These functions assume everything has been authenticated,
+// now we just perform action and save
+
+func handleMsgSetWithdrawAddress(ctx sdk.Context, msg MsgSetWithdrawAddress, k Keeper) sdk.Result {
+	// module accounts only receive coins through their module
+	if k.coinKeeper.IsModuleAccount(ctx, msg.WithdrawAddr) {
+		return ErrWithdrawAddrModuleAccount(k.codespace, msg.WithdrawAddr).Result()
+	}
+
+	k.SetDelegatorWithdrawAddr(ctx, msg.DelegatorAddr, msg.WithdrawAddr)
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionSetWithdrawAddress,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgWithdrawDelegatorReward(ctx sdk.Context, msg MsgWithdrawDelegatorReward, k Keeper) sdk.Result {
+	_, err := k.WithdrawDelegationReward(ctx, msg.DelegatorAddr, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionWithdrawDelegatorReward,
+		tags.Delegator, []byte(msg.DelegatorAddr.String()),
+		tags.Validator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgWithdrawValidatorCommission(ctx sdk.Context, msg MsgWithdrawValidatorCommission, k Keeper) sdk.Result {
+	_, err := k.WithdrawValidatorCommission(ctx, msg.ValidatorAddr)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionWithdrawValidatorCommission,
+		tags.Validator, []byte(msg.ValidatorAddr.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
@@ -0,0 +1,45 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Wrapper struct for the staking hooks of the distribution keeper
+type Hooks struct {
+	k Keeper
+}
+
+var _ sdk.StakingHooks = Hooks{}
+
+// Create new distribution hooks
+func (k Keeper) Hooks() Hooks { return Hooks{k} }
+
+// pay out the outstanding commission and remove the distribution info of a
+// deleted validator
+func (h Hooks) OnValidatorRemoved(ctx sdk.Context, addr sdk.AccAddress) {
+	_, err := h.k.WithdrawValidatorCommission(ctx, addr)
+	if err != nil {
+		panic(err)
+	}
+	h.k.RemoveValidatorDistInfo(ctx, addr)
+}
+
+// new delegations are only entitled to rewards from this point forward
+func (h Hooks) OnDelegationCreated(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	vdi := h.k.GetValidatorDistInfo(ctx, valAddr)
+	h.k.SetDelegationDistInfo(ctx, NewDelegationDistInfo(delAddr, valAddr, vdi.RewardsPerShare))
+}
+
+// withdraw the outstanding rewards before the shares they were earned
+// with are changed
+func (h Hooks) BeforeDelegationSharesModified(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	_, err := h.k.WithdrawDelegationReward(ctx, delAddr, valAddr)
+	if err != nil {
+		panic(err)
+	}
+}
+
+// remove the distribution info of a deleted delegation
+func (h Hooks) OnDelegationRemoved(ctx sdk.Context, delAddr, valAddr sdk.AccAddress) {
+	h.k.RemoveDelegationDistInfo(ctx, delAddr, valAddr)
+}
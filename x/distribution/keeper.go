@@ -1,91 +1,252 @@
-package stake
-
-//// keeper of the staking store
-//type Keeper struct {
-//storeKey   sdk.StoreKey
-//cdc        *wire.Codec
-//coinKeeper bank.Keeper
-
-//// codespace
-//codespace sdk.CodespaceType
-//}
-
-//func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.CodespaceType) Keeper {
-//keeper := Keeper{
-//storeKey:   key,
-//cdc:        cdc,
-//coinKeeper: ck,
-//codespace:  codespace,
-//}
-//return keeper
-//}
-
-////_________________________________________________________________________
-
-//// cummulative power of the non-absent prevotes
-//func (k Keeper) GetTotalPrecommitVotingPower(ctx sdk.Context) sdk.Dec {
-//store := ctx.KVStore(k.storeKey)
-
-//// get absent prevote indexes
-//absents := ctx.AbsentValidators()
-
-//TotalPower := sdk.ZeroDec()
-//i := int32(0)
-//iterator := store.SubspaceIterator(ValidatorsBondedKey)
-//for ; iterator.Valid(); iterator.Next() {
-
-//skip := false
-//for j, absentIndex := range absents {
-//if absentIndex > i {
-//break
-//}
-
-//// if non-voting validator found, skip adding its power
-//if absentIndex == i {
-//absents = append(absents[:j], absents[j+1:]...) // won't need again
-//skip = true
-//break
-//}
-//}
-//if skip {
-//continue
-//}
-
-//bz := iterator.Value()
-//var validator Validator
-//k.cdc.MustUnmarshalBinary(bz, &validator)
-//TotalPower = TotalPower.Add(validator.Power)
-//i++
-//}
-//iterator.Close()
-//return TotalPower
-//}
-
-////_______________________________________________________________________
-
-//// XXX TODO trim functionality
-
-//// retrieve all the power changes which occur after a height
-//func (k Keeper) GetPowerChangesAfterHeight(ctx sdk.Context, earliestHeight int64) (pcs []PowerChange) {
-//store := ctx.KVStore(k.storeKey)
-
-//iterator := store.SubspaceIterator(PowerChangeKey) //smallest to largest
-//for ; iterator.Valid(); iterator.Next() {
-//pcBytes := iterator.Value()
-//var pc PowerChange
-//k.cdc.MustUnmarshalBinary(pcBytes, &pc)
-//if pc.Height < earliestHeight {
-//break
-//}
-//pcs = append(pcs, pc)
-//}
-//iterator.Close()
-//return
-//}
-
-//// set a power change
-//func (k Keeper) setPowerChange(ctx sdk.Context, pc PowerChange) {
-//store := ctx.KVStore(k.storeKey)
-//b := k.cdc.MustMarshalBinary(pc)
-//store.Set(GetPowerChangeKey(pc.Height), b)
-//}
+package distribution
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// name of the module account holding the fees which have not been withdrawn yet
+const ModuleName = "distribution"
+
+// keeper of the distribution store
+type Keeper struct {
+	storeKey            sdk.StoreKey
+	cdc                 *wire.Codec
+	coinKeeper          bank.Keeper
+	stakeKeeper         stake.Keeper
+	feeCollectionKeeper auth.FeeCollectionKeeper
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, sk stake.Keeper,
+	fck auth.FeeCollectionKeeper, codespace sdk.CodespaceType) Keeper {
+
+	keeper := Keeper{
+		storeKey:            key,
+		cdc:                 cdc,
+		coinKeeper:          ck,
+		stakeKeeper:         sk,
+		feeCollectionKeeper: fck,
+		codespace:           codespace,
+	}
+	return keeper
+}
+
+// return the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+//______________________________________________________________________
+
+// get the validator distribution info, a fresh record is returned if
+// the validator has never received any rewards
+func (k Keeper) GetValidatorDistInfo(ctx sdk.Context,
+	operatorAddr sdk.AccAddress) (vdi ValidatorDistInfo) {
+
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetValidatorDistInfoKey(operatorAddr))
+	if b == nil {
+		return NewValidatorDistInfo(operatorAddr)
+	}
+	k.cdc.MustUnmarshalBinary(b, &vdi)
+	return
+}
+
+// set the validator distribution info
+func (k Keeper) SetValidatorDistInfo(ctx sdk.Context, vdi ValidatorDistInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(vdi)
+	store.Set(GetValidatorDistInfoKey(vdi.OperatorAddr), b)
+}
+
+// remove the validator distribution info
+func (k Keeper) RemoveValidatorDistInfo(ctx sdk.Context, operatorAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetValidatorDistInfoKey(operatorAddr))
+}
+
+// iterate over all the validator distribution infos
+func (k Keeper) IterateValidatorDistInfos(ctx sdk.Context,
+	fn func(index int64, vdi ValidatorDistInfo) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, ValidatorDistInfoKey)
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var vdi ValidatorDistInfo
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &vdi)
+		if fn(i, vdi) {
+			break
+		}
+		i++
+	}
+	iterator.Close()
+}
+
+//______________________________________________________________________
+
+// get the delegation distribution info, delegations without a record (such
+// as those created at genesis) are treated as never having withdrawn
+func (k Keeper) GetDelegationDistInfo(ctx sdk.Context,
+	delegatorAddr, validatorAddr sdk.AccAddress) (ddi DelegationDistInfo) {
+
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDelegationDistInfoKey(delegatorAddr, validatorAddr))
+	if b == nil {
+		return NewDelegationDistInfo(delegatorAddr, validatorAddr, sdk.DecCoins{})
+	}
+	k.cdc.MustUnmarshalBinary(b, &ddi)
+	return
+}
+
+// set the delegation distribution info
+func (k Keeper) SetDelegationDistInfo(ctx sdk.Context, ddi DelegationDistInfo) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(ddi)
+	store.Set(GetDelegationDistInfoKey(ddi.DelegatorAddr, ddi.ValidatorAddr), b)
+}
+
+// remove the delegation distribution info
+func (k Keeper) RemoveDelegationDistInfo(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetDelegationDistInfoKey(delegatorAddr, validatorAddr))
+}
+
+// iterate over all the delegation distribution infos
+func (k Keeper) IterateDelegationDistInfos(ctx sdk.Context,
+	fn func(index int64, ddi DelegationDistInfo) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DelegationDistInfoKey)
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var ddi DelegationDistInfo
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &ddi)
+		if fn(i, ddi) {
+			break
+		}
+		i++
+	}
+	iterator.Close()
+}
+
+//______________________________________________________________________
+
+// get the address the rewards of a delegator are withdrawn to, which is the
+// delegator itself unless set otherwise
+func (k Keeper) GetDelegatorWithdrawAddr(ctx sdk.Context, delegatorAddr sdk.AccAddress) sdk.AccAddress {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetDelegatorWithdrawAddrKey(delegatorAddr))
+	if b == nil {
+		return delegatorAddr
+	}
+	return sdk.AccAddress(b)
+}
+
+// set the address the rewards of a delegator are withdrawn to, setting the
+// delegator itself removes the record
+func (k Keeper) SetDelegatorWithdrawAddr(ctx sdk.Context, delegatorAddr, withdrawAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if bytes.Equal(delegatorAddr, withdrawAddr) {
+		store.Delete(GetDelegatorWithdrawAddrKey(delegatorAddr))
+		return
+	}
+	store.Set(GetDelegatorWithdrawAddrKey(delegatorAddr), withdrawAddr.Bytes())
+}
+
+// iterate over all the delegators with a withdraw address set
+func (k Keeper) IterateDelegatorWithdrawAddrs(ctx sdk.Context,
+	fn func(index int64, dwi DelegatorWithdrawInfo) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DelegatorWithdrawKey)
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		dwi := DelegatorWithdrawInfo{
+			DelegatorAddr: sdk.AccAddress(iterator.Key()[len(DelegatorWithdrawKey):]),
+			WithdrawAddr:  sdk.AccAddress(iterator.Value()),
+		}
+		if fn(i, dwi) {
+			break
+		}
+		i++
+	}
+	iterator.Close()
+}
+
+//______________________________________________________________________
+
+// get the global fee pool
+func (k Keeper) GetFeePool(ctx sdk.Context) (feePool FeePool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(FeePoolKey)
+	if b == nil {
+		return InitialFeePool()
+	}
+	k.cdc.MustUnmarshalBinary(b, &feePool)
+	return
+}
+
+// set the global fee pool
+func (k Keeper) SetFeePool(ctx sdk.Context, feePool FeePool) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(feePool)
+	store.Set(FeePoolKey, b)
+}
+
+// get the distribution parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(ParamsKey)
+	if b == nil {
+		panic("stored distribution params should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(b, &params)
+	return
+}
+
+// set the distribution parameters
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(params)
+	store.Set(ParamsKey, b)
+}
+
+// get the community tax rate
+func (k Keeper) GetCommunityTax(ctx sdk.Context) sdk.Dec {
+	return k.GetParams(ctx).CommunityTax
+}
+
+//______________________________________________________________________
+
+// send whole coins from the community pool to a recipient out of the
+// distribution module account, the remaining decimal fraction stays in the pool
+func (k Keeper) DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins,
+	recipient sdk.AccAddress) sdk.Error {
+
+	if !amount.IsValid() || !amount.IsPositive() {
+		return ErrInvalidPoolSpendAmount(k.codespace, amount)
+	}
+	if k.coinKeeper.IsModuleAccount(ctx, recipient) {
+		return ErrPoolSpendToModuleAccount(k.codespace, recipient)
+	}
+
+	feePool := k.GetFeePool(ctx)
+	spend := sdk.NewDecCoins(amount)
+	if feePool.CommunityPool.Minus(spend).HasNegative() {
+		return ErrInsufficientCommunityPool(k.codespace)
+	}
+	feePool.CommunityPool = feePool.CommunityPool.Minus(spend)
+	k.SetFeePool(ctx, feePool)
+
+	_, err := k.coinKeeper.SendCoins(ctx, auth.NewModuleAddress(ModuleName), recipient, amount)
+	return err
+}
@@ -1,31 +1,270 @@
-package stake
-
-//// test if is a gotValidator from the last update
-//func TestGetTotalPrecommitVotingPower(t *testing.T) {
-//ctx, _, keeper := createTestInput(t, false, 0)
-
-//amts := []int64{10000, 1000, 100, 10, 1}
-//var candidatesIn [5]Candidate
-//for i, amt := range amts {
-//candidatesIn[i] = NewCandidate(addrVals[i], pks[i], Description{})
-//candidatesIn[i].BondedShares = sdk.NewDec(amt)
-//candidatesIn[i].DelegatorShares = sdk.NewDec(amt)
-//keeper.setCandidate(ctx, candidatesIn[i])
-//}
-
-//// test that an empty gotValidator set doesn't have any gotValidators
-//gotValidators := keeper.GetValidators(ctx)
-//require.Equal(t, 5, len(gotValidators))
-
-//totPow := keeper.GetTotalPrecommitVotingPower(ctx)
-//exp := sdk.NewDec(11111)
-//require.True(t, exp.Equal(totPow), "exp %v, got %v", exp, totPow)
-
-//// set absent gotValidators to be the 1st and 3rd record sorted by pubKey address
-//ctx = ctx.WithAbsentValidators([]int32{1, 3})
-//totPow = keeper.GetTotalPrecommitVotingPower(ctx)
-
-//// XXX verify that this order should infact exclude these two records
-//exp = sdk.NewDec(11100)
-//require.True(t, exp.Equal(totPow), "exp %v, got %v", exp, totPow)
-//}
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestAllocateFeesSingleValidator(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	valAddr := addrs[0]
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(valAddr, pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// nothing to distribute yet
+	keeper.AllocateFees(ctx)
+	require.True(t, keeper.GetDelegationRewards(ctx, valAddr, valAddr).IsZero())
+
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+	require.True(t, fck.GetCollectedFees(ctx).IsZero())
+	distrAddr := auth.NewModuleAddress(ModuleName)
+	require.Equal(t, int64(10), ck.GetCoins(ctx, distrAddr).AmountOf("steak").Int64())
+
+	expRewards := sdk.DecCoins{sdk.NewDecCoin("steak", 10)}
+	require.True(t, expRewards.IsEqual(keeper.GetDelegationRewards(ctx, valAddr, valAddr)))
+	require.True(t, expRewards.IsEqual(keeper.GetDelegatorRewards(ctx, valAddr)))
+
+	// withdraw the rewards
+	withdrawn, err := keeper.WithdrawDelegationReward(ctx, valAddr, valAddr)
+	require.Nil(t, err)
+	require.True(t, withdrawn.IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 10)}))
+	require.Equal(t, int64(910), ck.GetCoins(ctx, valAddr).AmountOf("steak").Int64())
+	require.True(t, ck.GetCoins(ctx, distrAddr).IsZero())
+	require.True(t, keeper.GetDelegationRewards(ctx, valAddr, valAddr).IsZero())
+
+	// withdrawing again does not pay out anything
+	withdrawn, err = keeper.WithdrawDelegationReward(ctx, valAddr, valAddr)
+	require.Nil(t, err)
+	require.True(t, withdrawn.IsZero())
+}
+
+func TestAllocateFeesMultipleValidators(t *testing.T) {
+	ctx, _, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(addrs[0], pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	got = stakeHandler(ctx, newTestMsgCreateValidator(addrs[1], pks[1], 300))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// fees are split proportionally to the bonded tokens
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 40)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 10)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[0], addrs[0])))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 30)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])))
+}
+
+func TestAllocateFeesCommission(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	valAddr, delAddr := addrs[0], addrs[1]
+
+	msg := newTestMsgCreateValidator(valAddr, pks[0], 100)
+	msg.Commission = stake.NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	got := stakeHandler(ctx, msg)
+	require.True(t, got.IsOK(), "%v", got)
+	got = stakeHandler(ctx, stake.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("steak", 100)))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// the commission is taken off the top, the remainder is split by shares
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 10)}.IsEqual(keeper.GetValidatorDistInfo(ctx, valAddr).Commission))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 45)}.IsEqual(keeper.GetDelegationRewards(ctx, valAddr, valAddr)))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 45)}.IsEqual(keeper.GetDelegationRewards(ctx, delAddr, valAddr)))
+
+	// withdraw the commission
+	got = NewHandler(keeper)(ctx, NewMsgWithdrawValidatorCommission(valAddr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, int64(910), ck.GetCoins(ctx, valAddr).AmountOf("steak").Int64())
+	require.Equal(t, int64(90), ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).AmountOf("steak").Int64())
+	require.True(t, keeper.GetValidatorDistInfo(ctx, valAddr).Commission.IsZero())
+}
+
+func TestWithdrawDelegationRewardChange(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	valAddr, delAddr := addrs[0], addrs[1]
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(valAddr, pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	got = stakeHandler(ctx, stake.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("steak", 200)))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+	rewards := keeper.GetDelegationRewards(ctx, delAddr, valAddr)
+
+	// the whole coins are paid out of the module account, the decimal change
+	// is sent to the community pool
+	withdrawn, err := keeper.WithdrawDelegationReward(ctx, delAddr, valAddr)
+	require.Nil(t, err)
+	require.True(t, withdrawn.IsEqual(sdk.Coins{sdk.NewInt64Coin("steak", 6)}))
+	require.Equal(t, int64(806), ck.GetCoins(ctx, delAddr).AmountOf("steak").Int64())
+	require.Equal(t, int64(4), ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).AmountOf("steak").Int64())
+	require.True(t, rewards.Minus(sdk.NewDecCoins(withdrawn)).IsEqual(keeper.GetFeePool(ctx).CommunityPool))
+}
+
+func TestAllocateFeesProposerReward(t *testing.T) {
+	ctx, _, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(addrs[0], pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	got = stakeHandler(ctx, newTestMsgCreateValidator(addrs[1], pks[1], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// the first validator proposes a block including half of the precommit power
+	ctx = ctx.WithBlockHeader(abci.Header{Proposer: abci.Validator{Address: pks[0].Address()}})
+	ctx = ctx.WithSigningValidators([]abci.SigningValidator{
+		{Validator: abci.Validator{Address: pks[0].Address(), Power: 100}, SignedLastBlock: true},
+		{Validator: abci.Validator{Address: pks[1].Address(), Power: 100}, SignedLastBlock: false},
+	})
+	require.True(t, sdk.NewDecWithPrec(3, 2).Equal(ProposerRewardFraction(ctx)))
+
+	// 3% go to the proposer, the remainder is split by power
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 1000)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 515)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[0], addrs[0])))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 485)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])))
+
+	// an unknown proposer receives no bonus
+	ctx = ctx.WithBlockHeader(abci.Header{Proposer: abci.Validator{Address: pks[2].Address()}})
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 1000)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 1015)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[0], addrs[0])))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 985)}.IsEqual(keeper.GetDelegationRewards(ctx, addrs[1], addrs[1])))
+}
+
+func TestAllocateFeesCommunityTax(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	valAddr := addrs[0]
+
+	// without bonded validators everything is sent to the community pool
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 10)}.IsEqual(keeper.GetFeePool(ctx).CommunityPool))
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(valAddr, pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	// the community tax is taken before the rewards are split
+	keeper.SetParams(ctx, Params{CommunityTax: sdk.NewDecWithPrec(1, 1)})
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 20)}.IsEqual(keeper.GetFeePool(ctx).CommunityPool))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 90)}.IsEqual(keeper.GetDelegationRewards(ctx, valAddr, valAddr)))
+
+	// spend from the community pool
+	err := keeper.DistributeFromCommunityPool(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 25)}, addrs[1])
+	require.NotNil(t, err)
+	err = keeper.DistributeFromCommunityPool(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 15)}, auth.NewModuleAddress(auth.FeeCollectorName))
+	require.NotNil(t, err)
+	err = keeper.DistributeFromCommunityPool(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 15)}, addrs[1])
+	require.Nil(t, err)
+	require.Equal(t, int64(1015), ck.GetCoins(ctx, addrs[1]).AmountOf("steak").Int64())
+	require.Equal(t, int64(95), ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).AmountOf("steak").Int64())
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 5)}.IsEqual(keeper.GetFeePool(ctx).CommunityPool))
+
+	// the pool is exported
+	require.True(t, keeper.GetFeePool(ctx).CommunityPool.IsEqual(WriteGenesis(ctx, keeper).FeePool.CommunityPool))
+}
+
+func TestWithdrawAddress(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	handler := NewHandler(keeper)
+	valAddr, withdrawAddr := addrs[0], addrs[2]
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(valAddr, pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+	require.Equal(t, valAddr, keeper.GetDelegatorWithdrawAddr(ctx, valAddr))
+
+	// module accounts can not be withdraw addresses, even before they exist
+	got = handler(ctx, NewMsgSetWithdrawAddress(valAddr, auth.NewModuleAddress(ModuleName)))
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidInput), got.Code)
+	require.Equal(t, valAddr, keeper.GetDelegatorWithdrawAddr(ctx, valAddr))
+
+	got = handler(ctx, NewMsgSetWithdrawAddress(valAddr, withdrawAddr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, withdrawAddr, keeper.GetDelegatorWithdrawAddr(ctx, valAddr))
+
+	// explicit withdrawals are sent to the withdraw address
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+	got = handler(ctx, NewMsgWithdrawDelegatorReward(valAddr, valAddr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, int64(900), ck.GetCoins(ctx, valAddr).AmountOf("steak").Int64())
+	require.Equal(t, int64(1010), ck.GetCoins(ctx, withdrawAddr).AmountOf("steak").Int64())
+
+	// so are the withdrawals made when the delegation changes
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+	got = stakeHandler(ctx, stake.NewMsgDelegate(valAddr, valAddr, sdk.NewInt64Coin("steak", 100)))
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, int64(800), ck.GetCoins(ctx, valAddr).AmountOf("steak").Int64())
+	require.Equal(t, int64(1020), ck.GetCoins(ctx, withdrawAddr).AmountOf("steak").Int64())
+
+	// the withdraw address is exported
+	dwis := WriteGenesis(ctx, keeper).DelegatorWithdrawInfos
+	require.Equal(t, []DelegatorWithdrawInfo{{valAddr, withdrawAddr}}, dwis)
+
+	// resetting to the delegator removes the record
+	got = handler(ctx, NewMsgSetWithdrawAddress(valAddr, valAddr))
+	require.True(t, got.IsOK(), "%v", got)
+	require.Equal(t, valAddr, keeper.GetDelegatorWithdrawAddr(ctx, valAddr))
+	require.Equal(t, 0, len(WriteGenesis(ctx, keeper).DelegatorWithdrawInfos))
+}
+
+func TestDelegationRewardsLazyAccounting(t *testing.T) {
+	ctx, ck, sk, fck, keeper := createTestInput(t)
+	stakeHandler := stake.NewHandler(sk)
+	valAddr, delAddr := addrs[0], addrs[1]
+
+	got := stakeHandler(ctx, newTestMsgCreateValidator(valAddr, pks[0], 100))
+	require.True(t, got.IsOK(), "%v", got)
+	stake.EndBlocker(ctx, sk)
+
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	keeper.AllocateFees(ctx)
+
+	// a new delegation is not entitled to rewards from before it was created
+	got = stakeHandler(ctx, stake.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("steak", 100)))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, keeper.GetDelegationRewards(ctx, delAddr, valAddr).IsZero())
+
+	fck.AddCollectedFees(ctx, sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	keeper.AllocateFees(ctx)
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 20)}.IsEqual(keeper.GetDelegationRewards(ctx, valAddr, valAddr)))
+	require.True(t, sdk.DecCoins{sdk.NewDecCoin("steak", 10)}.IsEqual(keeper.GetDelegationRewards(ctx, delAddr, valAddr)))
+
+	// modifying the delegation withdraws the outstanding rewards
+	got = stakeHandler(ctx, stake.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("steak", 100)))
+	require.True(t, got.IsOK(), "%v", got)
+	require.True(t, keeper.GetDelegationRewards(ctx, delAddr, valAddr).IsZero())
+	require.Equal(t, int64(810), ck.GetCoins(ctx, delAddr).AmountOf("steak").Int64())
+
+	// removing the delegation withdraws the rewards and the distribution info
+	got = stakeHandler(ctx, stake.NewMsgBeginUnbonding(delAddr, valAddr, sdk.NewDec(200)))
+	require.True(t, got.IsOK(), "%v", got)
+	ddis := WriteGenesis(ctx, keeper).DelegationDistInfos
+	require.Equal(t, 1, len(ddis))
+	require.Equal(t, valAddr, ddis[0].DelegatorAddr)
+}
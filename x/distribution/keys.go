@@ -0,0 +1,35 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//nolint
+var (
+	// Keys for store prefixes
+	ValidatorDistInfoKey  = []byte{0x00} // prefix for each key to a validator distribution
+	DelegationDistInfoKey = []byte{0x01} // prefix for each key to a delegation distribution
+	FeePoolKey            = []byte{0x02} // key for the global fee pool
+	ParamsKey             = []byte{0x03} // key for the distribution parameters
+	DelegatorWithdrawKey  = []byte{0x04} // prefix for each key to a delegator's withdraw address
+)
+
+// get the key for a validator distribution
+func GetValidatorDistInfoKey(operatorAddr sdk.AccAddress) []byte {
+	return append(ValidatorDistInfoKey, operatorAddr.Bytes()...)
+}
+
+// get the key for a delegator distribution
+func GetDelegationDistInfoKey(delegatorAddr, validatorAddr sdk.AccAddress) []byte {
+	return append(GetDelegationDistInfosKey(delegatorAddr), validatorAddr.Bytes()...)
+}
+
+// get the prefix for a delegator for all delegation distributions
+func GetDelegationDistInfosKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(DelegationDistInfoKey, delegatorAddr.Bytes()...)
+}
+
+// get the key for a delegator's withdraw address
+func GetDelegatorWithdrawAddrKey(delegatorAddr sdk.AccAddress) []byte {
+	return append(DelegatorWithdrawKey, delegatorAddr.Bytes()...)
+}
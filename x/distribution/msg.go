@@ -0,0 +1,127 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "distr"
+
+// verify interface at compile time
+var _, _, _ sdk.Msg = &MsgSetWithdrawAddress{}, &MsgWithdrawDelegatorReward{}, &MsgWithdrawValidatorCommission{}
+
+// msg struct for changing the address the rewards of a delegator are withdrawn to
+type MsgSetWithdrawAddress struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	WithdrawAddr  sdk.AccAddress `json:"withdraw_addr"`
+}
+
+func NewMsgSetWithdrawAddress(delegatorAddr, withdrawAddr sdk.AccAddress) MsgSetWithdrawAddress {
+	return MsgSetWithdrawAddress{
+		DelegatorAddr: delegatorAddr,
+		WithdrawAddr:  withdrawAddr,
+	}
+}
+
+//nolint
+func (msg MsgSetWithdrawAddress) Type() string { return MsgType }
+func (msg MsgSetWithdrawAddress) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgSetWithdrawAddress) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgSetWithdrawAddress) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.WithdrawAddr == nil {
+		return ErrNilWithdrawAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// msg struct for withdrawing the rewards accrued by a delegation
+type MsgWithdrawDelegatorReward struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawDelegatorReward(delegatorAddr, validatorAddr sdk.AccAddress) MsgWithdrawDelegatorReward {
+	return MsgWithdrawDelegatorReward{
+		DelegatorAddr: delegatorAddr,
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+//nolint
+func (msg MsgWithdrawDelegatorReward) Type() string { return MsgType }
+func (msg MsgWithdrawDelegatorReward) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.DelegatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawDelegatorReward) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgWithdrawDelegatorReward) ValidateBasic() sdk.Error {
+	if msg.DelegatorAddr == nil {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// msg struct for withdrawing the commission accrued by a validator
+type MsgWithdrawValidatorCommission struct {
+	ValidatorAddr sdk.AccAddress `json:"validator_addr"`
+}
+
+func NewMsgWithdrawValidatorCommission(validatorAddr sdk.AccAddress) MsgWithdrawValidatorCommission {
+	return MsgWithdrawValidatorCommission{
+		ValidatorAddr: validatorAddr,
+	}
+}
+
+//nolint
+func (msg MsgWithdrawValidatorCommission) Type() string { return MsgType }
+func (msg MsgWithdrawValidatorCommission) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ValidatorAddr}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgWithdrawValidatorCommission) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgWithdrawValidatorCommission) ValidateBasic() sdk.Error {
+	if msg.ValidatorAddr == nil {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package distribution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgSetWithdrawAddress(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		withdrawAddr  sdk.AccAddress
+		expectPass    bool
+	}{
+		{addrs[0], addrs[1], true},
+		{addrs[0], addrs[0], true},
+		{nil, addrs[1], false},
+		{addrs[0], nil, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSetWithdrawAddress(tc.delegatorAddr, tc.withdrawAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.delegatorAddr}, msg.GetSigners())
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawDelegatorRewardValidation(t *testing.T) {
+	tests := []struct {
+		delegatorAddr sdk.AccAddress
+		validatorAddr sdk.AccAddress
+		expectPass    bool
+	}{
+		{addrs[0], addrs[1], true},
+		{addrs[0], addrs[0], true},
+		{nil, addrs[1], false},
+		{addrs[0], nil, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgWithdrawDelegatorReward(tc.delegatorAddr, tc.validatorAddr)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test index: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test index: %v", i)
+		}
+	}
+}
+
+func TestMsgWithdrawDelegatorRewardGetSigners(t *testing.T) {
+	msg := NewMsgWithdrawDelegatorReward(addrs[0], addrs[1])
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+}
+
+func TestMsgWithdrawValidatorCommission(t *testing.T) {
+	msg := NewMsgWithdrawValidatorCommission(addrs[0])
+	require.Nil(t, msg.ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addrs[0]}, msg.GetSigners())
+
+	msg = NewMsgWithdrawValidatorCommission(nil)
+	require.NotNil(t, msg.ValidateBasic())
+}
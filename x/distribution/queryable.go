@@ -0,0 +1,105 @@
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case "rewards":
+			return queryRewards(ctx, path[1:], req, keeper)
+		case "commission":
+			return queryCommission(ctx, path[1:], req, keeper)
+		case "withdraw_addr":
+			return queryWithdrawAddr(ctx, path[1:], req, keeper)
+		case "community_pool":
+			return queryCommunityPool(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown distr query endpoint")
+		}
+	}
+}
+
+// Params for query 'custom/distr/rewards'
+type QueryRewardsParams struct {
+	DelegatorAddr sdk.AccAddress
+	ValidatorAddr sdk.AccAddress // if empty, the rewards of all delegations are summed
+}
+
+func queryRewards(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryRewardsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	var rewards sdk.DecCoins
+	if len(params.ValidatorAddr) == 0 {
+		rewards = keeper.GetDelegatorRewards(ctx, params.DelegatorAddr)
+	} else {
+		rewards = keeper.GetDelegationRewards(ctx, params.DelegatorAddr, params.ValidatorAddr)
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, rewards)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/distr/commission'
+type QueryCommissionParams struct {
+	ValidatorAddr sdk.AccAddress
+}
+
+func queryCommission(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryCommissionParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	commission := keeper.GetValidatorDistInfo(ctx, params.ValidatorAddr).Commission
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, commission)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryCommunityPool(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	communityPool := keeper.GetFeePool(ctx).CommunityPool
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, communityPool)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+// Params for query 'custom/distr/withdraw_addr'
+type QueryWithdrawAddrParams struct {
+	DelegatorAddr sdk.AccAddress
+}
+
+func queryWithdrawAddr(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryWithdrawAddrParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	withdrawAddr := keeper.GetDelegatorWithdrawAddr(ctx, params.DelegatorAddr)
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, withdrawAddr)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
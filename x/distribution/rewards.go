@@ -0,0 +1,82 @@
+package distribution
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+)
+
+// get the rewards accrued by a delegation which have not yet been withdrawn
+func (k Keeper) GetDelegationRewards(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddress) sdk.DecCoins {
+	delegation, found := k.stakeKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	if !found {
+		return sdk.DecCoins{}
+	}
+
+	vdi := k.GetValidatorDistInfo(ctx, validatorAddr)
+	ddi := k.GetDelegationDistInfo(ctx, delegatorAddr, validatorAddr)
+	return ddi.Rewards(vdi, delegation.Shares)
+}
+
+// get the rewards accrued across all of the delegations of a delegator
+func (k Keeper) GetDelegatorRewards(ctx sdk.Context, delegatorAddr sdk.AccAddress) sdk.DecCoins {
+	rewards := sdk.DecCoins{}
+	k.stakeKeeper.IterateDelegations(ctx, delegatorAddr, func(_ int64, delegation sdk.Delegation) (stop bool) {
+		vdi := k.GetValidatorDistInfo(ctx, delegation.GetValidator())
+		ddi := k.GetDelegationDistInfo(ctx, delegatorAddr, delegation.GetValidator())
+		rewards = rewards.Plus(ddi.Rewards(vdi, delegation.GetBondShares()))
+		return false
+	})
+	return rewards
+}
+
+// withdraw the rewards accrued by a delegation to the delegator's withdraw
+// address
+func (k Keeper) WithdrawDelegationReward(ctx sdk.Context,
+	delegatorAddr, validatorAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+
+	delegation, found := k.stakeKeeper.GetDelegation(ctx, delegatorAddr, validatorAddr)
+	if !found {
+		return nil, ErrNoDelegationForAddress(k.codespace)
+	}
+
+	vdi := k.GetValidatorDistInfo(ctx, validatorAddr)
+	ddi := k.GetDelegationDistInfo(ctx, delegatorAddr, validatorAddr)
+
+	// only whole coins can be withdrawn, the decimal change is sent to the
+	// community pool
+	withdraw, change := ddi.Rewards(vdi, delegation.Shares).TruncateDecimal()
+
+	ddi.RewardsPerShare = vdi.RewardsPerShare
+	k.SetDelegationDistInfo(ctx, ddi)
+	k.fundCommunityPool(ctx, change)
+
+	if len(withdraw) == 0 {
+		return withdraw, nil
+	}
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, delegatorAddr)
+	_, err := k.coinKeeper.SendCoins(ctx, auth.NewModuleAddress(ModuleName), withdrawAddr, withdraw)
+	if err != nil {
+		return nil, err
+	}
+	return withdraw, nil
+}
+
+// withdraw the commission accrued by a validator to the operator's withdraw
+// address, the decimal change remains with the validator
+func (k Keeper) WithdrawValidatorCommission(ctx sdk.Context, operatorAddr sdk.AccAddress) (sdk.Coins, sdk.Error) {
+	vdi := k.GetValidatorDistInfo(ctx, operatorAddr)
+
+	withdraw, change := vdi.Commission.TruncateDecimal()
+	vdi.Commission = change
+	k.SetValidatorDistInfo(ctx, vdi)
+
+	if len(withdraw) == 0 {
+		return withdraw, nil
+	}
+	withdrawAddr := k.GetDelegatorWithdrawAddr(ctx, operatorAddr)
+	_, err := k.coinKeeper.SendCoins(ctx, auth.NewModuleAddress(ModuleName), withdrawAddr, withdraw)
+	if err != nil {
+		return nil, err
+	}
+	return withdraw, nil
+}
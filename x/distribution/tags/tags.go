@@ -0,0 +1,16 @@
+// nolint
+package tags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ActionSetWithdrawAddress          = []byte("set-withdraw-address")
+	ActionWithdrawDelegatorReward     = []byte("withdraw-delegator-reward")
+	ActionWithdrawValidatorCommission = []byte("withdraw-validator-commission")
+
+	Action    = sdk.TagAction
+	Validator = sdk.TagDstValidator
+	Delegator = sdk.TagDelegator
+)
@@ -1,107 +1,103 @@
-package stake
-
-//// GenesisState - all staking state that must be provided at genesis
-//type GenesisState struct {
-//Pool   Pool   `json:"pool"`
-//Params Params `json:"params"`
-//}
-
-//func NewGenesisState(pool Pool, params Params, candidates []Candidate, bonds []Delegation) GenesisState {
-//return GenesisState{
-//Pool:   pool,
-//Params: params,
-//}
-//}
-
-//// get raw genesis raw message for testing
-//func DefaultGenesisState() GenesisState {
-//return GenesisState{
-//Pool:   initialPool(),
-//Params: defaultParams(),
-//}
-//}
-
-//// fee information for a validator
-//type Validator struct {
-//Adjustments      []sdk.Dec `json:"fee_adjustments"`    // XXX Adjustment factors for lazy fee accounting, couples with Params.BondDenoms
-//PrevBondedShares sdk.Dec   `json:"prev_bonded_shares"` // total shares of a global hold pools
-//}
-
-////_________________________________________________________________________
-
-//// Params defines the high level settings for staking
-//type Params struct {
-//FeeDenoms      []string `json:"fee_denoms"`       // accepted fee denoms
-//ReservePoolFee sdk.Dec  `json:"reserve_pool_fee"` // percent of fees which go to reserve pool
-//}
-
-//func (p Params) equal(p2 Params) bool {
-//return p.BondDenom == p2.BondDenom &&
-//p.ReservePoolFee.Equal(p2.ReservePoolFee)
-//}
-
-//func defaultParams() Params {
-//return Params{
-//FeeDenoms:      []string{"steak"},
-//ReservePoolFee: sdk.NewDec(5, 100),
-//}
-//}
-
-////_________________________________________________________________________
-
-//// Pool - dynamic parameters of the current state
-//type Pool struct {
-//FeeReservePool   sdk.Coins `json:"fee_reserve_pool"`   // XXX reserve pool of collected fees for use by governance
-//FeePool          sdk.Coins `json:"fee_pool"`           // XXX fee pool for all the fee shares which have already been distributed
-//FeeSumReceived   sdk.Coins `json:"fee_sum_received"`   // XXX sum of all fees received, post reserve pool `json:"fee_sum_received"`
-//FeeRecent        sdk.Coins `json:"fee_recent"`         // XXX most recent fee collected
-//FeeAdjustments   []sdk.Dec `json:"fee_adjustments"`    // XXX Adjustment factors for lazy fee accounting, couples with Params.BondDenoms
-//PrevBondedShares sdk.Dec   `json:"prev_bonded_shares"` // XXX last recorded bonded shares
-//}
-
-//func (p Pool) equal(p2 Pool) bool {
-//return p.FeeReservePool.IsEqual(p2.FeeReservePool) &&
-//p.FeePool.IsEqual(p2.FeePool) &&
-//p.FeeSumReceived.IsEqual(p2.FeeSumReceived) &&
-//p.FeeRecent.IsEqual(p2.FeeRecent) &&
-//sdk.DecsEqual(p.FeeAdjustments, p2.FeeAdjustments) &&
-//p.PrevBondedShares.Equal(p2.PrevBondedShares)
-//}
-
-//// initial pool for testing
-//func initialPool() Pool {
-//return Pool{
-//FeeReservePool:   sdk.Coins(nil),
-//FeePool:          sdk.Coins(nil),
-//FeeSumReceived:   sdk.Coins(nil),
-//FeeRecent:        sdk.Coins(nil),
-//FeeAdjustments:   []sdk.Dec{sdk.ZeroDec()},
-//PrevBondedShares: sdk.ZeroDec(),
-//}
-//}
-
-////_________________________________________________________________________
-
-//// Used in calculation of fee shares, added to a queue for each block where a power change occures
-//type PowerChange struct {
-//Height      int64     `json:"height"`        // block height at change
-//Power       sdk.Dec   `json:"power"`         // total power at change
-//PrevPower   sdk.Dec   `json:"prev_power"`    // total power at previous height-1
-//FeesIn      sdk.Coins `json:"fees_in"`       // fees in at block height
-//PrevFeePool sdk.Coins `json:"prev_fee_pool"` // total fees in at previous block height
-//}
-
-////_________________________________________________________________________
-//// KEY MANAGEMENT
-
-//var (
-//// Keys for store prefixes
-//PowerChangeKey = []byte{0x09} // prefix for power change object
-//)
-
-//// get the key for the accumulated update validators
-//func GetPowerChangeKey(height int64) []byte {
-//heightBytes := make([]byte, binary.MaxVarintLen64)
-//binary.BigEndian.PutUint64(heightBytes, ^uint64(height)) // invert height (older validators first)
-//return append(PowerChangeKey, heightBytes...)
-//}
+package distribution
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// distribution info for a particular validator
+//
+// Rewards are tracked per delegator share so that each delegation can lazily
+// compute its portion as shares * (RewardsPerShare - the delegation's last
+// recorded RewardsPerShare), without iterating over delegations each block.
+// The validator's commission is taken off the top and accumulated separately.
+type ValidatorDistInfo struct {
+	OperatorAddr    sdk.AccAddress `json:"operator_addr"`
+	RewardsPerShare sdk.DecCoins   `json:"rewards_per_share"` // cumulative rewards earned per delegator share
+	Commission      sdk.DecCoins   `json:"commission"`        // commission earned by the operator which has not been withdrawn
+}
+
+func NewValidatorDistInfo(operatorAddr sdk.AccAddress) ValidatorDistInfo {
+	return ValidatorDistInfo{
+		OperatorAddr:    operatorAddr,
+		RewardsPerShare: sdk.DecCoins{},
+		Commission:      sdk.DecCoins{},
+	}
+}
+
+// HumanReadableString returns a human readable string representation of the
+// validator distribution info.
+func (vdi ValidatorDistInfo) HumanReadableString() string {
+	resp := "Validator Distribution Info \n"
+	resp += fmt.Sprintf("Operator: %s\n", vdi.OperatorAddr)
+	resp += fmt.Sprintf("Rewards Per Share: %s\n", vdi.RewardsPerShare)
+	resp += fmt.Sprintf("Commission: %s\n", vdi.Commission)
+	return resp
+}
+
+//_______________________________________________________________________
+
+// distribution info for a delegation
+type DelegationDistInfo struct {
+	DelegatorAddr   sdk.AccAddress `json:"delegator_addr"`
+	ValidatorAddr   sdk.AccAddress `json:"validator_addr"`
+	RewardsPerShare sdk.DecCoins   `json:"rewards_per_share"` // validator rewards per share at the last withdrawal
+}
+
+func NewDelegationDistInfo(delegatorAddr, validatorAddr sdk.AccAddress,
+	rewardsPerShare sdk.DecCoins) DelegationDistInfo {
+
+	return DelegationDistInfo{
+		DelegatorAddr:   delegatorAddr,
+		ValidatorAddr:   validatorAddr,
+		RewardsPerShare: rewardsPerShare,
+	}
+}
+
+// rewards owed to a delegation holding the provided shares
+func (ddi DelegationDistInfo) Rewards(vdi ValidatorDistInfo, shares sdk.Dec) sdk.DecCoins {
+	return vdi.RewardsPerShare.Minus(ddi.RewardsPerShare).MulDec(shares)
+}
+
+//_______________________________________________________________________
+
+// global fee pool for distribution
+type FeePool struct {
+	CommunityPool sdk.DecCoins `json:"community_pool"` // pool for community funds yet to be spent
+}
+
+// zero fee pool
+func InitialFeePool() FeePool {
+	return FeePool{
+		CommunityPool: sdk.DecCoins{},
+	}
+}
+
+// distribution parameters
+type Params struct {
+	CommunityTax sdk.Dec `json:"community_tax"` // fraction of the rewards sent to the community pool
+}
+
+// default distribution parameters
+func DefaultParams() Params {
+	return Params{
+		CommunityTax: sdk.NewDecWithPrec(2, 2), // 2%
+	}
+}
+
+// validate the distribution parameters
+func (p Params) Validate() error {
+	if p.CommunityTax.IsNil() || p.CommunityTax.LT(sdk.ZeroDec()) || p.CommunityTax.GT(sdk.OneDec()) {
+		return fmt.Errorf("community tax must be between 0 and 1, is %v", p.CommunityTax)
+	}
+	return nil
+}
+
+//_______________________________________________________________________
+
+// the address a delegator's rewards are withdrawn to
+type DelegatorWithdrawInfo struct {
+	DelegatorAddr sdk.AccAddress `json:"delegator_addr"`
+	WithdrawAddr  sdk.AccAddress `json:"withdraw_addr"`
+}
@@ -0,0 +1,191 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowance is an allowance of fees which a granter pays for the txs of a
+// grantee.
+type FeeAllowance interface {
+	// Accept checks whether the fee may be paid from the allowance at the given
+	// block time and returns the allowance reduced by the fee. remove is true
+	// once the allowance is used up and the grant should be deleted.
+	Accept(fee sdk.Coins, blockTime time.Time) (updated FeeAllowance, remove bool, err sdk.Error)
+
+	// Restore returns the allowance with the refund of a fee it accepted
+	// given back
+	Restore(refund sdk.Coins) FeeAllowance
+
+	// quick validity check of the allowance
+	ValidateBasic() sdk.Error
+}
+
+//______________________________________________________________________
+
+var _ FeeAllowance = BasicFeeAllowance{}
+
+// BasicFeeAllowance allows the grantee to spend fees up to a total limit
+// until it expires.
+type BasicFeeAllowance struct {
+	SpendLimit sdk.Coins `json:"spend_limit"` // if empty, there is no spend limit
+	Expiration time.Time `json:"expiration"`  // if zero, the allowance does not expire
+}
+
+func NewBasicFeeAllowance(spendLimit sdk.Coins, expiration time.Time) BasicFeeAllowance {
+	return BasicFeeAllowance{
+		SpendLimit: spendLimit,
+		Expiration: expiration,
+	}
+}
+
+// whether the allowance is expired at the given block time
+func (a BasicFeeAllowance) isExpired(blockTime time.Time) bool {
+	return !a.Expiration.IsZero() && !blockTime.Before(a.Expiration)
+}
+
+// Implements FeeAllowance.
+func (a BasicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (FeeAllowance, bool, sdk.Error) {
+	if a.isExpired(blockTime) {
+		return nil, false, ErrFeeAllowanceExpired(DefaultCodespace)
+	}
+	if len(a.SpendLimit) == 0 {
+		return a, false, nil
+	}
+
+	left := a.SpendLimit.Minus(fee)
+	if !left.IsNotNegative() {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.SpendLimit)
+	}
+	a.SpendLimit = left
+	return a, left.IsZero(), nil
+}
+
+// Implements FeeAllowance.
+func (a BasicFeeAllowance) Restore(refund sdk.Coins) FeeAllowance {
+	if len(a.SpendLimit) == 0 {
+		return a
+	}
+	a.SpendLimit = a.SpendLimit.Plus(refund)
+	return a
+}
+
+// Implements FeeAllowance.
+func (a BasicFeeAllowance) ValidateBasic() sdk.Error {
+	if len(a.SpendLimit) != 0 && (!a.SpendLimit.IsValid() || !a.SpendLimit.IsPositive()) {
+		return sdk.ErrInvalidCoins(a.SpendLimit.String())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+var _ FeeAllowance = PeriodicFeeAllowance{}
+
+// PeriodicFeeAllowance extends a BasicFeeAllowance with a limit of the fees
+// which may be spent each period. The coins which may be spent are reset to
+// the period spend limit at the start of every period, unspent coins do not
+// carry over.
+type PeriodicFeeAllowance struct {
+	Basic            BasicFeeAllowance `json:"basic"`              // total spend limit and expiration
+	Period           time.Duration     `json:"period"`             // length of a period
+	PeriodSpendLimit sdk.Coins         `json:"period_spend_limit"` // coins which may be spent each period
+	PeriodCanSpend   sdk.Coins         `json:"period_can_spend"`   // coins left to spend in the current period
+	PeriodReset      time.Time         `json:"period_reset"`       // time at which the next period starts
+}
+
+// NewPeriodicFeeAllowance returns a PeriodicFeeAllowance whose first period
+// starts with the first fee it pays.
+func NewPeriodicFeeAllowance(basic BasicFeeAllowance, period time.Duration, periodSpendLimit sdk.Coins) PeriodicFeeAllowance {
+	return PeriodicFeeAllowance{
+		Basic:            basic,
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// start a new period if the current one is over, the next period starts a
+// full period after the given block time if more than one period was skipped
+func (a *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(a.PeriodReset) {
+		return
+	}
+	a.PeriodCanSpend = a.PeriodSpendLimit
+	a.PeriodReset = a.PeriodReset.Add(a.Period)
+	if !blockTime.Before(a.PeriodReset) {
+		a.PeriodReset = blockTime.Add(a.Period)
+	}
+}
+
+// Implements FeeAllowance.
+func (a PeriodicFeeAllowance) Accept(fee sdk.Coins, blockTime time.Time) (FeeAllowance, bool, sdk.Error) {
+	if a.Basic.isExpired(blockTime) {
+		return nil, false, ErrFeeAllowanceExpired(DefaultCodespace)
+	}
+
+	a.tryResetPeriod(blockTime)
+	periodLeft := a.PeriodCanSpend.Minus(fee)
+	if !periodLeft.IsNotNegative() {
+		return nil, false, ErrFeeLimitExceeded(DefaultCodespace, fee, a.PeriodCanSpend)
+	}
+	a.PeriodCanSpend = periodLeft
+
+	basic, remove, err := a.Basic.Accept(fee, blockTime)
+	if err != nil {
+		return nil, false, err
+	}
+	a.Basic = basic.(BasicFeeAllowance)
+	return a, remove, nil
+}
+
+// Implements FeeAllowance.
+func (a PeriodicFeeAllowance) Restore(refund sdk.Coins) FeeAllowance {
+	a.PeriodCanSpend = a.PeriodCanSpend.Plus(refund)
+	a.Basic = a.Basic.Restore(refund).(BasicFeeAllowance)
+	return a
+}
+
+// Implements FeeAllowance.
+func (a PeriodicFeeAllowance) ValidateBasic() sdk.Error {
+	err := a.Basic.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	if a.Period <= 0 {
+		return ErrInvalidPeriod(DefaultCodespace)
+	}
+	if !a.PeriodSpendLimit.IsValid() || !a.PeriodSpendLimit.IsPositive() {
+		return sdk.ErrInvalidCoins(a.PeriodSpendLimit.String())
+	}
+	return nil
+}
+
+//______________________________________________________________________
+
+// FeeAllowanceGrant is an allowance granted by a granter to a grantee
+type FeeAllowanceGrant struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+func NewFeeAllowanceGrant(granter, grantee sdk.AccAddress, allowance FeeAllowance) FeeAllowanceGrant {
+	return FeeAllowanceGrant{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+// ValidateBasic validates the addresses and the allowance of the grant
+func (grant FeeAllowanceGrant) ValidateBasic() sdk.Error {
+	err := validateGrantAddrs(grant.Granter, grant.Grantee)
+	if err != nil {
+		return err
+	}
+	if grant.Allowance == nil {
+		return ErrNilFeeAllowance(DefaultCodespace)
+	}
+	return grant.Allowance.ValidateBasic()
+}
@@ -0,0 +1,94 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBasicFeeAllowanceAccept(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	limit := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	fee := sdk.Coins{sdk.NewInt64Coin("steak", 40)}
+
+	// without a limit or expiration any fee is accepted
+	allowance, remove, err := NewBasicFeeAllowance(nil, time.Time{}).Accept(fee, now)
+	require.Nil(t, err)
+	require.False(t, remove)
+	require.Equal(t, NewBasicFeeAllowance(nil, time.Time{}), allowance)
+
+	// the fee is deducted from the spend limit
+	allowance, remove, err = NewBasicFeeAllowance(limit, time.Time{}).Accept(fee, now)
+	require.Nil(t, err)
+	require.False(t, remove)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 60)}, allowance.(BasicFeeAllowance).SpendLimit)
+
+	// the allowance is removed once the spend limit is used up
+	_, remove, err = NewBasicFeeAllowance(fee, time.Time{}).Accept(fee, now)
+	require.Nil(t, err)
+	require.True(t, remove)
+
+	// fees above the spend limit or in another denomination are rejected
+	_, _, err = NewBasicFeeAllowance(fee, time.Time{}).Accept(limit, now)
+	require.NotNil(t, err)
+	_, _, err = NewBasicFeeAllowance(limit, time.Time{}).Accept(sdk.Coins{sdk.NewInt64Coin("fee", 1)}, now)
+	require.NotNil(t, err)
+
+	// an expired allowance rejects any fee
+	_, _, err = NewBasicFeeAllowance(limit, now.Add(time.Hour)).Accept(fee, now.Add(time.Hour))
+	require.NotNil(t, err)
+}
+
+func TestPeriodicFeeAllowanceAccept(t *testing.T) {
+	now := time.Unix(0, 0).UTC()
+	periodLimit := sdk.Coins{sdk.NewInt64Coin("steak", 50)}
+	fee := sdk.Coins{sdk.NewInt64Coin("steak", 30)}
+	basic := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 100)}, time.Time{})
+
+	// the first fee starts the first period
+	allowance, remove, err := NewPeriodicFeeAllowance(basic, time.Hour, periodLimit).Accept(fee, now)
+	require.Nil(t, err)
+	require.False(t, remove)
+	periodic := allowance.(PeriodicFeeAllowance)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 20)}, periodic.PeriodCanSpend)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 70)}, periodic.Basic.SpendLimit)
+	require.Equal(t, now.Add(time.Hour), periodic.PeriodReset)
+
+	// the period limit is exceeded within the period
+	_, _, err = periodic.Accept(fee, now.Add(30*time.Minute))
+	require.NotNil(t, err)
+
+	// the period limit is reset in the next period
+	allowance, _, err = periodic.Accept(fee, now.Add(time.Hour))
+	require.Nil(t, err)
+	periodic = allowance.(PeriodicFeeAllowance)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 20)}, periodic.PeriodCanSpend)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 40)}, periodic.Basic.SpendLimit)
+	require.Equal(t, now.Add(2*time.Hour), periodic.PeriodReset)
+
+	// the next period starts a full period after a skipped period
+	allowance, _, err = periodic.Accept(fee, now.Add(5*time.Hour))
+	require.Nil(t, err)
+	periodic = allowance.(PeriodicFeeAllowance)
+	require.Equal(t, now.Add(6*time.Hour), periodic.PeriodReset)
+
+	// the total spend limit still applies
+	_, _, err = periodic.Accept(fee, now.Add(6*time.Hour))
+	require.NotNil(t, err)
+}
+
+func TestFeeAllowanceValidateBasic(t *testing.T) {
+	limit := sdk.Coins{sdk.NewInt64Coin("steak", 100)}
+	basic := NewBasicFeeAllowance(limit, time.Time{})
+
+	require.Nil(t, basic.ValidateBasic())
+	require.Nil(t, NewBasicFeeAllowance(nil, time.Time{}).ValidateBasic())
+	require.NotNil(t, NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 0)}, time.Time{}).ValidateBasic())
+
+	require.Nil(t, NewPeriodicFeeAllowance(basic, time.Hour, limit).ValidateBasic())
+	require.NotNil(t, NewPeriodicFeeAllowance(basic, 0, limit).ValidateBasic())
+	require.NotNil(t, NewPeriodicFeeAllowance(basic, time.Hour, nil).ValidateBasic())
+}
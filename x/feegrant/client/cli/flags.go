@@ -0,0 +1,9 @@
+package cli
+
+// nolint
+const (
+	FlagSpendLimit  = "spend-limit"
+	FlagExpiration  = "expiration"
+	FlagPeriod      = "period"
+	FlagPeriodLimit = "period-limit"
+)
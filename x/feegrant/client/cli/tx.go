@@ -0,0 +1,134 @@
+package cli
+
+import (
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	authcmd "github.com/cosmos/cosmos-sdk/x/auth/client/cli"
+	authctx "github.com/cosmos/cosmos-sdk/x/auth/client/context"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+)
+
+// GetCmdGrantFeeAllowance implements the grant fee allowance command.
+func GetCmdGrantFeeAllowance(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-fee-allowance [grantee-addr]",
+		Short: "allow the grantee to pay the fees of its txs from the sender's account",
+		Long: `Grant a fee allowance to the grantee, replacing any allowance the sender
+already granted to it. Without a spend limit or expiration the grantee may pay any
+fees from the sender's account. With a period, the fees paid in each period are
+limited by the period limit.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granterAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			allowance, err := buildFeeAllowance()
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, allowance)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagSpendLimit, "", "total fees the grantee may pay, e.g. 100steak (default unlimited)")
+	cmd.Flags().String(FlagExpiration, "", "time at which the allowance expires, in RFC3339 format (default never)")
+	cmd.Flags().String(FlagPeriod, "", "length of a period in which the fees are limited by the period limit, e.g. 24h")
+	cmd.Flags().String(FlagPeriodLimit, "", "fees the grantee may pay each period, e.g. 10steak")
+
+	return cmd
+}
+
+// build the fee allowance described by the command flags
+func buildFeeAllowance() (feegrant.FeeAllowance, error) {
+	spendLimit, err := sdk.ParseCoins(viper.GetString(FlagSpendLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	var expiration time.Time
+	if expirationStr := viper.GetString(FlagExpiration); expirationStr != "" {
+		expiration, err = time.Parse(time.RFC3339, expirationStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	basic := feegrant.NewBasicFeeAllowance(spendLimit, expiration)
+
+	periodStr := viper.GetString(FlagPeriod)
+	if periodStr == "" {
+		if viper.GetString(FlagPeriodLimit) != "" {
+			return nil, errors.Errorf("--%s requires --%s", FlagPeriodLimit, FlagPeriod)
+		}
+		return basic, nil
+	}
+
+	period, err := time.ParseDuration(periodStr)
+	if err != nil {
+		return nil, err
+	}
+	periodLimit, err := sdk.ParseCoins(viper.GetString(FlagPeriodLimit))
+	if err != nil {
+		return nil, err
+	}
+	return feegrant.NewPeriodicFeeAllowance(basic, period, periodLimit), nil
+}
+
+// GetCmdRevokeFeeAllowance implements the revoke fee allowance command.
+func GetCmdRevokeFeeAllowance(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-fee-allowance [grantee-addr]",
+		Short: "revoke the fee allowance the sender granted to the grantee",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			granterAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := feegrant.NewMsgRevokeFeeAllowance(granterAddr, granteeAddr)
+
+			// build and sign the transaction, then broadcast to Tendermint
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,45 @@
+//nolint
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type CodeType = sdk.CodeType
+
+const (
+	DefaultCodespace sdk.CodespaceType = 11
+
+	CodeInvalidInput        CodeType = 101
+	CodeNoAllowance         CodeType = 102
+	CodeFeeAllowanceExpired CodeType = 103
+	CodeFeeLimitExceeded    CodeType = 104
+	CodeInvalidFeeAllowance CodeType = 105
+)
+
+func ErrNilGranterAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "granter address is nil")
+}
+func ErrNilGranteeAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "grantee address is nil")
+}
+func ErrSelfGrant(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidInput, "cannot grant a fee allowance to oneself")
+}
+func ErrNoAllowance(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAllowance, "no fee allowance exists between that granter and grantee")
+}
+func ErrFeeAllowanceExpired(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceExpired, "fee allowance is expired")
+}
+func ErrFeeLimitExceeded(codespace sdk.CodespaceType, fee, limit sdk.Coins) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeLimitExceeded, fmt.Sprintf("fee %v exceeds the allowed fees %v", fee, limit))
+}
+func ErrNilFeeAllowance(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, "fee allowance is nil")
+}
+func ErrInvalidPeriod(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, "fee allowance period must be positive")
+}
@@ -0,0 +1,55 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all fee grant state that must be provided at genesis
+type GenesisState struct {
+	FeeGrants []FeeAllowanceGrant `json:"fee_grants"`
+}
+
+func NewGenesisState(grants []FeeAllowanceGrant) GenesisState {
+	return GenesisState{
+		FeeGrants: grants,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// InitGenesis sets the fee allowance grants for genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	err := ValidateGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+	for _, grant := range data.FeeGrants {
+		keeper.GrantFeeAllowance(ctx, grant)
+	}
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper. The
+// GenesisState will contain all the fee allowance grants.
+func WriteGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	grants := []FeeAllowanceGrant{}
+	keeper.IterateFeeGrants(ctx, func(_ int64, grant FeeAllowanceGrant) (stop bool) {
+		grants = append(grants, grant)
+		return false
+	})
+	return NewGenesisState(grants)
+}
+
+// ValidateGenesis performs basic validation of the fee allowance grants
+// returning an error for any invalid grant.
+func ValidateGenesis(data GenesisState) error {
+	for _, grant := range data.FeeGrants {
+		err := grant.ValidateBasic()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant/tags"
+)
+
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		// NOTE msg already has validate basic run
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("invalid message parse in feegrant module").Result()
+		}
+	}
+}
+
+//_____________________________________________________________________
+
+// These functions assume everything has been authenticated,
+// now we just perform action and save
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, msg MsgGrantFeeAllowance, k Keeper) sdk.Result {
+	k.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(msg.Granter, msg.Grantee, msg.Allowance))
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionGrantFeeAllowance,
+		tags.Granter, []byte(msg.Granter.String()),
+		tags.Grantee, []byte(msg.Grantee.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, msg MsgRevokeFeeAllowance, k Keeper) sdk.Result {
+	err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee)
+	if err != nil {
+		return err.Result()
+	}
+
+	tags := sdk.NewTags(
+		tags.Action, tags.ActionRevokeFeeAllowance,
+		tags.Granter, []byte(msg.Granter.String()),
+		tags.Grantee, []byte(msg.Grantee.String()),
+	)
+	return sdk.Result{
+		Tags: tags,
+	}
+}
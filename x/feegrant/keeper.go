@@ -0,0 +1,126 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// keeper of the fee grant store
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      *wire.Codec
+
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, codespace sdk.CodespaceType) Keeper {
+	keeper := Keeper{
+		storeKey:  key,
+		cdc:       cdc,
+		codespace: codespace,
+	}
+	return keeper
+}
+
+// return the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+//______________________________________________________________________
+
+// get the fee allowance granted by a granter to a grantee
+func (k Keeper) GetFeeGrant(ctx sdk.Context, granter, grantee sdk.AccAddress) (grant FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(GetFeeAllowanceKey(granter, grantee))
+	if b == nil {
+		return grant, false
+	}
+	k.cdc.MustUnmarshalBinary(b, &grant)
+	return grant, true
+}
+
+// set a fee allowance grant, replacing any allowance the granter already
+// granted to the grantee
+func (k Keeper) GrantFeeAllowance(ctx sdk.Context, grant FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(grant)
+	store.Set(GetFeeAllowanceKey(grant.Granter, grant.Grantee), b)
+}
+
+// remove the fee allowance granted by a granter to a grantee
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) sdk.Error {
+	store := ctx.KVStore(k.storeKey)
+	key := GetFeeAllowanceKey(granter, grantee)
+	if !store.Has(key) {
+		return ErrNoAllowance(k.codespace)
+	}
+	store.Delete(key)
+	return nil
+}
+
+// iterate over the fee allowances granted to a grantee
+func (k Keeper) IterateGranteeFeeGrants(ctx sdk.Context, grantee sdk.AccAddress,
+	fn func(index int64, grant FeeAllowanceGrant) (stop bool)) {
+
+	k.iterateFeeGrants(ctx, GetFeeAllowancesKey(grantee), fn)
+}
+
+// iterate over all fee allowance grants
+func (k Keeper) IterateFeeGrants(ctx sdk.Context,
+	fn func(index int64, grant FeeAllowanceGrant) (stop bool)) {
+
+	k.iterateFeeGrants(ctx, FeeAllowanceKey, fn)
+}
+
+func (k Keeper) iterateFeeGrants(ctx sdk.Context, prefix []byte,
+	fn func(index int64, grant FeeAllowanceGrant) (stop bool)) {
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	i := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		var grant FeeAllowanceGrant
+		k.cdc.MustUnmarshalBinary(iterator.Value(), &grant)
+		if fn(i, grant) {
+			break
+		}
+		i++
+	}
+	iterator.Close()
+}
+
+// UseGrantedFees charges a fee to the allowance granted by a granter to a
+// grantee, the grant is removed once its allowance is used up. The fee itself
+// is deducted from the granter's account by the ante handler.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins) sdk.Error {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return ErrNoAllowance(k.codespace)
+	}
+
+	allowance, remove, err := grant.Allowance.Accept(fee, ctx.BlockHeader().Time)
+	if err != nil {
+		return err
+	}
+	if remove {
+		return k.RevokeFeeAllowance(ctx, granter, grantee)
+	}
+
+	grant.Allowance = allowance
+	k.GrantFeeAllowance(ctx, grant)
+	return nil
+}
+
+// RestoreGrantedFees gives the refund of a fee paid by a granter back to the
+// allowance granted to the grantee. A grant removed since the fee was charged,
+// because the fee used it up or the granter revoked it, is not restored.
+func (k Keeper) RestoreGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) {
+	grant, found := k.GetFeeGrant(ctx, granter, grantee)
+	if !found {
+		return
+	}
+	grant.Allowance = grant.Allowance.Restore(refund)
+	k.GrantFeeAllowance(ctx, grant)
+}
@@ -0,0 +1,118 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestKeeperGrantRevoke(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 100)}, time.Time{})
+
+	_, found := keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.False(t, found)
+
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(addrs[0], addrs[1], allowance))
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(addrs[2], addrs[1], allowance))
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(addrs[1], addrs[0], allowance))
+
+	grant, found := keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.True(t, found)
+	require.Equal(t, allowance, grant.Allowance)
+
+	// only the allowances granted to the grantee are iterated
+	var granters []sdk.AccAddress
+	keeper.IterateGranteeFeeGrants(ctx, addrs[1], func(_ int64, grant FeeAllowanceGrant) (stop bool) {
+		require.Equal(t, addrs[1], grant.Grantee)
+		granters = append(granters, grant.Granter)
+		return false
+	})
+	require.Len(t, granters, 2)
+
+	err := keeper.RevokeFeeAllowance(ctx, addrs[0], addrs[1])
+	require.Nil(t, err)
+	_, found = keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.False(t, found)
+
+	// an allowance which does not exist cannot be revoked
+	err = keeper.RevokeFeeAllowance(ctx, addrs[0], addrs[1])
+	require.NotNil(t, err)
+}
+
+func TestKeeperUseGrantedFees(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 100)}, time.Time{})
+	keeper.GrantFeeAllowance(ctx, NewFeeAllowanceGrant(addrs[0], addrs[1], allowance))
+
+	// no allowance was granted by the granter
+	err := keeper.UseGrantedFees(ctx, addrs[2], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+	require.NotNil(t, err)
+
+	// the fee is charged to the allowance
+	err = keeper.UseGrantedFees(ctx, addrs[0], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 60)})
+	require.Nil(t, err)
+	grant, found := keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 40)}, grant.Allowance.(BasicFeeAllowance).SpendLimit)
+
+	// the fee exceeds the allowance
+	err = keeper.UseGrantedFees(ctx, addrs[0], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 60)})
+	require.NotNil(t, err)
+
+	// the refund of a fee is given back to the allowance
+	keeper.RestoreGrantedFees(ctx, addrs[0], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	grant, found = keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.True(t, found)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin("steak", 60)}, grant.Allowance.(BasicFeeAllowance).SpendLimit)
+	err = keeper.UseGrantedFees(ctx, addrs[0], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 20)})
+	require.Nil(t, err)
+
+	// the grant is removed once the allowance is used up
+	err = keeper.UseGrantedFees(ctx, addrs[0], addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 40)})
+	require.Nil(t, err)
+	_, found = keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.False(t, found)
+}
+
+func TestHandlerGrantRevoke(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	handler := NewHandler(keeper)
+	allowance := NewBasicFeeAllowance(nil, time.Time{})
+
+	res := handler(ctx, NewMsgGrantFeeAllowance(addrs[0], addrs[1], allowance))
+	require.True(t, res.IsOK(), "%v", res)
+	_, found := keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.True(t, found)
+
+	res = handler(ctx, NewMsgRevokeFeeAllowance(addrs[0], addrs[1]))
+	require.True(t, res.IsOK(), "%v", res)
+	_, found = keeper.GetFeeGrant(ctx, addrs[0], addrs[1])
+	require.False(t, found)
+
+	res = handler(ctx, NewMsgRevokeFeeAllowance(addrs[0], addrs[1]))
+	require.False(t, res.IsOK())
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, keeper := createTestInput(t)
+	allowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 100)}, time.Time{})
+
+	// the grants round trip through genesis
+	grants := []FeeAllowanceGrant{NewFeeAllowanceGrant(addrs[0], addrs[1], allowance)}
+	InitGenesis(ctx, keeper, NewGenesisState(grants))
+	require.Equal(t, grants, WriteGenesis(ctx, keeper).FeeGrants)
+
+	// invalid grants are rejected
+	require.Nil(t, ValidateGenesis(DefaultGenesisState()))
+	selfGrant := NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(addrs[0], addrs[0], allowance)})
+	require.NotNil(t, ValidateGenesis(selfGrant))
+	require.Panics(t, func() { InitGenesis(ctx, keeper, selfGrant) })
+	nilAllowance := NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(addrs[0], addrs[1], nil)})
+	require.NotNil(t, ValidateGenesis(nilAllowance))
+	zeroAllowance := NewBasicFeeAllowance(sdk.Coins{sdk.NewInt64Coin("steak", 0)}, time.Time{})
+	require.NotNil(t, ValidateGenesis(NewGenesisState([]FeeAllowanceGrant{NewFeeAllowanceGrant(addrs[0], addrs[1], zeroAllowance)})))
+}
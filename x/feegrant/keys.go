@@ -0,0 +1,21 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//nolint
+var (
+	// Keys for store prefixes
+	FeeAllowanceKey = []byte{0x00} // prefix for each key to a fee allowance grant
+)
+
+// get the key for the fee allowance granted by a granter to a grantee
+func GetFeeAllowanceKey(granter, grantee sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesKey(grantee), granter.Bytes()...)
+}
+
+// get the prefix for all the fee allowances granted to a grantee
+func GetFeeAllowancesKey(grantee sdk.AccAddress) []byte {
+	return append(FeeAllowanceKey, grantee.Bytes()...)
+}
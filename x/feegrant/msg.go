@@ -0,0 +1,105 @@
+package feegrant
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// name to identify transaction types
+const MsgType = "feegrant"
+
+// verify interface at compile time
+var _, _ sdk.Msg = &MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{}
+
+// msg struct for granting a fee allowance to a grantee
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+}
+
+func NewMsgGrantFeeAllowance(granter, grantee sdk.AccAddress, allowance FeeAllowance) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+	}
+}
+
+//nolint
+func (msg MsgGrantFeeAllowance) Type() string { return MsgType }
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	err := validateGrantAddrs(msg.Granter, msg.Grantee)
+	if err != nil {
+		return err
+	}
+	if msg.Allowance == nil {
+		return ErrNilFeeAllowance(DefaultCodespace)
+	}
+	return msg.Allowance.ValidateBasic()
+}
+
+//______________________________________________________________________
+
+// msg struct for revoking the fee allowance granted to a grantee
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+func NewMsgRevokeFeeAllowance(granter, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+//nolint
+func (msg MsgRevokeFeeAllowance) Type() string { return MsgType }
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+// get the bytes for the message signer to sign on
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// quick validity check
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	return validateGrantAddrs(msg.Granter, msg.Grantee)
+}
+
+//______________________________________________________________________
+
+func validateGrantAddrs(granter, grantee sdk.AccAddress) sdk.Error {
+	if granter == nil {
+		return ErrNilGranterAddr(DefaultCodespace)
+	}
+	if grantee == nil {
+		return ErrNilGranteeAddr(DefaultCodespace)
+	}
+	if bytes.Equal(granter, grantee) {
+		return ErrSelfGrant(DefaultCodespace)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package feegrant
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case "grants":
+			return queryGrants(ctx, path[1:], req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown feegrant query endpoint")
+		}
+	}
+}
+
+// Params for query 'custom/feegrant/grants'
+type QueryGrantsParams struct {
+	Grantee sdk.AccAddress
+}
+
+func queryGrants(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	var params QueryGrantsParams
+	err2 := keeper.cdc.UnmarshalJSON(req.Data, &params)
+	if err2 != nil {
+		return []byte{}, sdk.ErrUnknownRequest(fmt.Sprintf("incorrectly formatted request data - %s", err2.Error()))
+	}
+
+	grants := []FeeAllowanceGrant{}
+	keeper.IterateGranteeFeeGrants(ctx, params.Grantee, func(_ int64, grant FeeAllowanceGrant) (stop bool) {
+		grants = append(grants, grant)
+		return false
+	})
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, grants)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
@@ -0,0 +1,15 @@
+// nolint
+package tags
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	ActionGrantFeeAllowance  = []byte("grant-fee-allowance")
+	ActionRevokeFeeAllowance = []byte("revoke-fee-allowance")
+
+	Action  = sdk.TagAction
+	Granter = "granter"
+	Grantee = "grantee"
+)
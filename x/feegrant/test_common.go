@@ -0,0 +1,45 @@
+package feegrant
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+var (
+	addrs = []sdk.AccAddress{
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}
+)
+
+func createTestCodec() *wire.Codec {
+	cdc := wire.NewCodec()
+	sdk.RegisterWire(cdc)
+	RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+	return cdc
+}
+
+func createTestInput(t *testing.T) (sdk.Context, Keeper) {
+	keyFeeGrant := sdk.NewKVStoreKey("feegrant")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyFeeGrant, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewTMLogger(os.Stdout))
+	keeper := NewKeeper(createTestCodec(), keyFeeGrant, DefaultCodespace)
+	return ctx, keeper
+}
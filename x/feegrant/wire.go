@@ -0,0 +1,21 @@
+package feegrant
+
+import (
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+// Register concrete types on wire codec
+func RegisterWire(cdc *wire.Codec) {
+	cdc.RegisterInterface((*FeeAllowance)(nil), nil)
+	cdc.RegisterConcrete(BasicFeeAllowance{}, "cosmos-sdk/BasicFeeAllowance", nil)
+	cdc.RegisterConcrete(PeriodicFeeAllowance{}, "cosmos-sdk/PeriodicFeeAllowance", nil)
+
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "cosmos-sdk/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "cosmos-sdk/MsgRevokeFeeAllowance", nil)
+}
+
+var msgCdc = wire.NewCodec()
+
+func init() {
+	RegisterWire(msgCdc)
+}
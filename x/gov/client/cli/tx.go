@@ -14,6 +14,7 @@ import (
 
 	"encoding/json"
 	"io/ioutil"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -28,10 +29,16 @@ const (
 	flagDeposit           = "deposit"
 	flagVoter             = "voter"
 	flagOption            = "option"
+	flagOptions           = "options"
 	flagDepositer         = "depositer"
 	flagStatus            = "status"
 	flagLatestProposalIDs = "latest"
 	flagProposal          = "proposal"
+	flagRecipient         = "recipient"
+	flagAmount            = "amount"
+	flagUpgradeName       = "upgrade-name"
+	flagUpgradeHeight     = "upgrade-height"
+	flagUpgradeInfo       = "upgrade-info"
 )
 
 type proposal struct {
@@ -39,6 +46,24 @@ type proposal struct {
 	Description string
 	Type        string
 	Deposit     string
+	Recipient   string
+	Amount      string
+	Changes     []paramChange
+	Plan        upgradePlan
+}
+
+// a parameter change of a proposal JSON file, whose value is any JSON
+type paramChange struct {
+	Module string
+	Key    string
+	Value  json.RawMessage
+}
+
+// the upgrade plan of a software upgrade proposal
+type upgradePlan struct {
+	Name   string
+	Height string
+	Info   string
 }
 
 var proposalFlags = []string{
@@ -46,6 +71,11 @@ var proposalFlags = []string{
 	flagDescription,
 	flagProposalType,
 	flagDeposit,
+	flagRecipient,
+	flagAmount,
+	flagUpgradeName,
+	flagUpgradeHeight,
+	flagUpgradeInfo,
 }
 
 // GetCmdSubmitProposal implements submitting a proposal transaction command.
@@ -70,6 +100,26 @@ where proposal.json contains:
 is equivalent to
 
 $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome proposal" --type="Text" --deposit="1000test"
+
+A CommunityPoolSpend proposal additionally requires the recipient and the amount to send from the community pool:
+
+$ gaiacli gov submit-proposal --title="Fund Development" --description="Pay for development" --type="CommunityPoolSpend" --deposit="1000test" --recipient="cosmosaccaddr1..." --amount="500test"
+
+A ParameterChange proposal must be given through a proposal JSON file listing the parameters to change when the proposal passes, each of which is set to its JSON value:
+
+{
+  "title": "Longer Voting Period",
+  "description": "Double the voting period",
+  "type": "parameter-change",
+  "deposit": "1000test",
+  "changes": [
+    {"module": "gov", "key": "votingprocedure", "value": {"voting_period": "345600000000000"}}
+  ]
+}
+
+A SoftwareUpgrade proposal additionally requires the name and height of the upgrade plan, at which the chain halts until a binary handling the upgrade is started:
+
+$ gaiacli gov submit-proposal --title="Upgrade" --description="Upgrade to v1" --type="software-upgrade" --deposit="1000test" --upgrade-name="v1" --upgrade-height="100000" --upgrade-info="https://example.com/v1"
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			proposal, err := parseSubmitProposalFlags()
@@ -99,6 +149,36 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 			}
 
 			msg := gov.NewMsgSubmitProposal(proposal.Title, proposal.Description, proposalType, fromAddr, amount)
+			if proposalType == gov.ProposalTypeCommunityPoolSpend {
+				recipient, err := sdk.AccAddressFromBech32(proposal.Recipient)
+				if err != nil {
+					return err
+				}
+
+				spend, err := sdk.ParseCoins(proposal.Amount)
+				if err != nil {
+					return err
+				}
+
+				msg = gov.NewMsgSubmitCommunityPoolSpendProposal(proposal.Title, proposal.Description, fromAddr, amount, recipient, spend)
+			}
+			if proposalType == gov.ProposalTypeParameterChange {
+				changes := make([]gov.ParamChange, len(proposal.Changes))
+				for i, change := range proposal.Changes {
+					changes[i] = gov.NewParamChange(change.Module, change.Key, string(change.Value))
+				}
+
+				msg = gov.NewMsgSubmitParameterChangeProposal(proposal.Title, proposal.Description, fromAddr, amount, changes)
+			}
+			if proposalType == gov.ProposalTypeSoftwareUpgrade {
+				height, err := strconv.ParseInt(proposal.Plan.Height, 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid upgrade height %s: %v", proposal.Plan.Height, err)
+				}
+
+				plan := gov.NewPlan(proposal.Plan.Name, height, proposal.Plan.Info)
+				msg = gov.NewMsgSubmitSoftwareUpgradeProposal(proposal.Title, proposal.Description, fromAddr, amount, plan)
+			}
 
 			err = msg.ValidateBasic()
 			if err != nil {
@@ -116,6 +196,11 @@ $ gaiacli gov submit-proposal --title="Test Proposal" --description="My awesome
 	cmd.Flags().String(flagDescription, "", "description of proposal")
 	cmd.Flags().String(flagProposalType, "", "proposalType of proposal")
 	cmd.Flags().String(flagDeposit, "", "deposit of proposal")
+	cmd.Flags().String(flagRecipient, "", "recipient of the community pool spend (CommunityPoolSpend proposals only)")
+	cmd.Flags().String(flagAmount, "", "amount to send from the community pool (CommunityPoolSpend proposals only)")
+	cmd.Flags().String(flagUpgradeName, "", "name of the upgrade plan (SoftwareUpgrade proposals only)")
+	cmd.Flags().String(flagUpgradeHeight, "", "height at which the chain halts for the upgrade (SoftwareUpgrade proposals only)")
+	cmd.Flags().String(flagUpgradeInfo, "", "information about the upgrade, like where to get the binary (SoftwareUpgrade proposals only)")
 	cmd.Flags().String(flagProposal, "", "proposal file path (if this path is given, other proposal flags are ignored)")
 
 	return cmd
@@ -130,6 +215,11 @@ func parseSubmitProposalFlags() (*proposal, error) {
 		proposal.Description = viper.GetString(flagDescription)
 		proposal.Type = viper.GetString(flagProposalType)
 		proposal.Deposit = viper.GetString(flagDeposit)
+		proposal.Recipient = viper.GetString(flagRecipient)
+		proposal.Amount = viper.GetString(flagAmount)
+		proposal.Plan.Name = viper.GetString(flagUpgradeName)
+		proposal.Plan.Height = viper.GetString(flagUpgradeHeight)
+		proposal.Plan.Info = viper.GetString(flagUpgradeInfo)
 		return proposal, nil
 	}
 
@@ -243,6 +333,52 @@ func GetCmdVote(cdc *wire.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCmdVoteWeighted implements creating a new weighted vote command.
+func GetCmdVoteWeighted(cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "weighted-vote",
+		Short: "vote for an active proposal, splitting the voting power across options, e.g. Yes=0.6,No=0.4",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			txCtx := authctx.NewTxContextFromCLI().WithCodec(cdc)
+			cliCtx := context.NewCLIContext().
+				WithCodec(cdc).
+				WithLogger(os.Stdout).
+				WithAccountDecoder(authcmd.GetAccountDecoder(cdc))
+
+			voterAddr, err := cliCtx.GetFromAddress()
+			if err != nil {
+				return err
+			}
+
+			proposalID := viper.GetInt64(flagProposalID)
+			options, err := gov.WeightedVoteOptionsFromString(viper.GetString(flagOptions))
+			if err != nil {
+				return err
+			}
+
+			msg := gov.NewMsgVoteWeighted(voterAddr, proposalID, options)
+
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Vote[Voter:%s,ProposalID:%d,Options:%s]",
+				voterAddr.String(), msg.ProposalID, msg.Options.String(),
+			)
+
+			// Build and sign the transaction, then broadcast to a Tendermint
+			// node.
+			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(flagProposalID, "", "proposalID of proposal voting on")
+	cmd.Flags().String(flagOptions, "", "weighted vote options {Yes, No, NoWithVeto, Abstain} with weights summing to 1, e.g. Yes=0.6,No=0.4")
+
+	return cmd
+}
+
 // GetCmdQueryProposal implements the query proposal command.
 func GetCmdQueryProposal(queryRoute string, cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -530,3 +666,45 @@ func GetCmdQueryTally(queryRoute string, cdc *wire.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQueryUpgradePlan implements the command to query the scheduled upgrade plan.
+func GetCmdQueryUpgradePlan(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-upgrade-plan",
+		Short: "get the upgrade plan scheduled by a passed software upgrade proposal",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/upgrade_plan", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdQueryAppliedUpgrade implements the command to query the last applied upgrade plan.
+func GetCmdQueryAppliedUpgrade(queryRoute string, cdc *wire.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query-applied-upgrade",
+		Short: "get the last upgrade plan applied by the running binary",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/applied_upgrade", queryRoute), nil)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -17,6 +17,20 @@ func TestParseSubmitProposalFlags(t *testing.T) {
   "type": "Text",
   "deposit": "1000test"
 }
+`)
+
+	changeJSON, err := ioutil.TempFile("", "proposal")
+	require.Nil(t, err, "unexpected error")
+	changeJSON.WriteString(`
+{
+  "title": "Test Proposal",
+  "description": "My awesome proposal",
+  "type": "parameter-change",
+  "deposit": "1000test",
+  "changes": [
+    {"module": "gov", "key": "votingprocedure", "value": {"voting_period": "400"}}
+  ]
+}
 `)
 
 	badJSON, err := ioutil.TempFile("", "proposal")
@@ -42,6 +56,16 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 	require.Equal(t, "Text", proposal1.Type)
 	require.Equal(t, "1000test", proposal1.Deposit)
 
+	// parameter change json, keeping the raw JSON values
+	viper.Set(flagProposal, changeJSON.Name())
+	changeProposal, err := parseSubmitProposalFlags()
+	require.Nil(t, err, "unexpected error")
+	require.Equal(t, 1, len(changeProposal.Changes))
+	require.Equal(t, "gov", changeProposal.Changes[0].Module)
+	require.Equal(t, "votingprocedure", changeProposal.Changes[0].Key)
+	require.Equal(t, `{"voting_period": "400"}`, string(changeProposal.Changes[0].Value))
+	viper.Set(flagProposal, okJSON.Name())
+
 	// flags that can't be used with --proposal
 	for _, incompatibleFlag := range proposalFlags {
 		viper.Set(incompatibleFlag, "some value")
@@ -65,6 +89,8 @@ func TestParseSubmitProposalFlags(t *testing.T) {
 
 	err = okJSON.Close()
 	require.Nil(t, err, "unexpected error")
+	err = changeJSON.Close()
+	require.Nil(t, err, "unexpected error")
 	err = badJSON.Close()
 	require.Nil(t, err, "unexpected error")
 }
@@ -38,15 +38,22 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
 	r.HandleFunc(fmt.Sprintf("/gov/proposals/{%s}/votes", RestProposalID), queryVotesOnProposalHandlerFn(cdc)).Methods("GET")
 
 	r.HandleFunc("/gov/proposals", queryProposalsWithParameterFn(cdc)).Methods("GET")
+
+	r.HandleFunc("/gov/upgrade/plan", queryUpgradeHandlerFn(cdc, "upgrade_plan")).Methods("GET")
+	r.HandleFunc("/gov/upgrade/applied", queryUpgradeHandlerFn(cdc, "applied_upgrade")).Methods("GET")
 }
 
 type postProposalReq struct {
-	BaseReq        baseReq          `json:"base_req"`
-	Title          string           `json:"title"`           //  Title of the proposal
-	Description    string           `json:"description"`     //  Description of the proposal
-	ProposalType   gov.ProposalKind `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
-	Proposer       sdk.AccAddress   `json:"proposer"`        //  Address of the proposer
-	InitialDeposit sdk.Coins        `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	BaseReq        baseReq           `json:"base_req"`
+	Title          string            `json:"title"`           //  Title of the proposal
+	Description    string            `json:"description"`     //  Description of the proposal
+	ProposalType   gov.ProposalKind  `json:"proposal_type"`   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
+	Proposer       sdk.AccAddress    `json:"proposer"`        //  Address of the proposer
+	InitialDeposit sdk.Coins         `json:"initial_deposit"` // Coins to add to the proposal's deposit
+	Recipient      sdk.AccAddress    `json:"recipient"`       // Recipient of a community pool spend
+	Amount         sdk.Coins         `json:"amount"`          // Coins to send from the community pool
+	Changes        []gov.ParamChange `json:"changes"`         // Parameter changes of a parameter change proposal
+	Plan           gov.Plan          `json:"plan"`            // Upgrade plan of a software upgrade proposal
 }
 
 type depositReq struct {
@@ -56,9 +63,10 @@ type depositReq struct {
 }
 
 type voteReq struct {
-	BaseReq baseReq        `json:"base_req"`
-	Voter   sdk.AccAddress `json:"voter"`  //  address of the voter
-	Option  gov.VoteOption `json:"option"` //  option from OptionSet chosen by the voter
+	BaseReq baseReq                 `json:"base_req"`
+	Voter   sdk.AccAddress          `json:"voter"`   //  address of the voter
+	Option  gov.VoteOption          `json:"option"`  //  option from OptionSet chosen by the voter
+	Options gov.WeightedVoteOptions `json:"options"` //  weighted options splitting the voting power, in place of option
 }
 
 func postProposalHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -75,6 +83,18 @@ func postProposalHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.Hand
 
 		// create the message
 		msg := gov.NewMsgSubmitProposal(req.Title, req.Description, req.ProposalType, req.Proposer, req.InitialDeposit)
+		if req.ProposalType == gov.ProposalTypeCommunityPoolSpend {
+			msg = gov.NewMsgSubmitCommunityPoolSpendProposal(req.Title, req.Description, req.Proposer,
+				req.InitialDeposit, req.Recipient, req.Amount)
+		}
+		if req.ProposalType == gov.ProposalTypeParameterChange {
+			msg = gov.NewMsgSubmitParameterChangeProposal(req.Title, req.Description, req.Proposer,
+				req.InitialDeposit, req.Changes)
+		}
+		if req.ProposalType == gov.ProposalTypeSoftwareUpgrade {
+			msg = gov.NewMsgSubmitSoftwareUpgradeProposal(req.Title, req.Description, req.Proposer,
+				req.InitialDeposit, req.Plan)
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
@@ -147,8 +167,11 @@ func voteHandlerFn(cdc *wire.Codec, cliCtx context.CLIContext) http.HandlerFunc
 			return
 		}
 
-		// create the message
-		msg := gov.NewMsgVote(req.Voter, proposalID, req.Option)
+		// create the message, a weighted vote if options are given
+		var msg sdk.Msg = gov.NewMsgVote(req.Voter, proposalID, req.Option)
+		if len(req.Options) > 0 {
+			msg = gov.NewMsgVoteWeighted(req.Voter, proposalID, req.Options)
+		}
 		err = msg.ValidateBasic()
 		if err != nil {
 			utils.WriteErrorResponse(&w, http.StatusBadRequest, err.Error())
@@ -481,3 +504,18 @@ func queryTallyOnProposalHandlerFn(cdc *wire.Codec) http.HandlerFunc {
 		w.Write(res)
 	}
 }
+
+// query the scheduled or the last applied upgrade plan
+func queryUpgradeHandlerFn(cdc *wire.Codec, route string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/gov/%s", route), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		w.Write(res)
+	}
+}
@@ -20,6 +20,7 @@ type baseReq struct {
 	AccountNumber int64  `json:"account_number"`
 	Sequence      int64  `json:"sequence"`
 	Gas           int64  `json:"gas"`
+	TimeoutHeight int64  `json:"timeout_height"`
 }
 
 func buildReq(w http.ResponseWriter, r *http.Request, cdc *wire.Codec, req interface{}) error {
@@ -74,6 +75,7 @@ func signAndBuild(w http.ResponseWriter, cliCtx context.CLIContext, baseReq base
 		Sequence:      baseReq.Sequence,
 		ChainID:       baseReq.ChainID,
 		Gas:           baseReq.Gas,
+		TimeoutHeight: baseReq.TimeoutHeight,
 	}
 
 	if baseReq.Gas == 0 {
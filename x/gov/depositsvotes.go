@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
@@ -10,14 +11,14 @@ import (
 
 // Vote
 type Vote struct {
-	Voter      sdk.AccAddress `json:"voter"`       //  address of the voter
-	ProposalID int64          `json:"proposal_id"` //  proposalID of the proposal
-	Option     VoteOption     `json:"option"`      //  option from OptionSet chosen by the voter
+	Voter      sdk.AccAddress      `json:"voter"`       //  address of the voter
+	ProposalID int64               `json:"proposal_id"` //  proposalID of the proposal
+	Options    WeightedVoteOptions `json:"options"`     //  options from OptionSet chosen by the voter, with the fraction of the voting power given to each
 }
 
 // Returns whether 2 votes are equal
 func (voteA Vote) Equals(voteB Vote) bool {
-	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Option == voteB.Option
+	return voteA.Voter.Equals(voteB.Voter) && voteA.ProposalID == voteB.ProposalID && voteA.Options.Equals(voteB.Options)
 }
 
 // Returns whether a vote is empty
@@ -83,6 +84,101 @@ func validVoteOption(option VoteOption) bool {
 	return false
 }
 
+// WeightedVoteOption is a vote option with the fraction of the voter's voting
+// power given to it
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	Weight sdk.Dec    `json:"weight"`
+}
+
+func NewWeightedVoteOption(option VoteOption, weight sdk.Dec) WeightedVoteOption {
+	return WeightedVoteOption{
+		Option: option,
+		Weight: weight,
+	}
+}
+
+func (o WeightedVoteOption) String() string {
+	return fmt.Sprintf("%s=%s", o.Option, o.Weight)
+}
+
+// WeightedVoteOptions splits the voting power of a voter across vote options
+type WeightedVoteOptions []WeightedVoteOption
+
+// Returns the options of a vote giving all the voting power to one option
+func NewNonSplitVoteOption(option VoteOption) WeightedVoteOptions {
+	return WeightedVoteOptions{NewWeightedVoteOption(option, sdk.OneDec())}
+}
+
+// Returns whether 2 weighted vote options are equal
+func (options WeightedVoteOptions) Equals(other WeightedVoteOptions) bool {
+	if len(options) != len(other) {
+		return false
+	}
+	for i, o := range options {
+		if o.Option != other[i].Option || !o.Weight.Equal(other[i].Weight) {
+			return false
+		}
+	}
+	return true
+}
+
+func (options WeightedVoteOptions) String() string {
+	strs := make([]string, len(options))
+	for i, o := range options {
+		strs[i] = o.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// Returns an error unless the options are distinct valid vote options with
+// positive weights summing to one
+func (options WeightedVoteOptions) Validate() error {
+	if len(options) == 0 {
+		return errors.New("no vote options")
+	}
+	seen := make(map[VoteOption]bool)
+	totalWeight := sdk.ZeroDec()
+	for _, o := range options {
+		if !validVoteOption(o.Option) {
+			return errors.Errorf("'%s' is not a valid vote option", o.Option)
+		}
+		if seen[o.Option] {
+			return errors.Errorf("duplicate vote option %s", o.Option)
+		}
+		if o.Weight.IsNil() || !o.Weight.GT(sdk.ZeroDec()) || o.Weight.GT(sdk.OneDec()) {
+			return errors.Errorf("weight of vote option %s must be between 0 and 1, is %v", o.Option, o.Weight)
+		}
+		seen[o.Option] = true
+		totalWeight = totalWeight.Add(o.Weight)
+	}
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return errors.Errorf("weights of the vote options must sum to 1, sum to %v", totalWeight)
+	}
+	return nil
+}
+
+// Parses weighted vote options like "Yes=0.6,No=0.4"
+func WeightedVoteOptionsFromString(str string) (WeightedVoteOptions, error) {
+	var options WeightedVoteOptions
+	for _, optionStr := range strings.Split(str, ",") {
+		parts := strings.SplitN(strings.TrimSpace(optionStr), "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Errorf("'%s' is not a weighted vote option, expected option=weight", optionStr)
+		}
+		option, err := VoteOptionFromString(parts[0])
+		if err != nil {
+			return nil, err
+		}
+		weight, err := sdk.NewDecFromStr(parts[1])
+		if err != nil {
+			return nil, errors.Errorf("invalid weight of vote option %s: %s", parts[0], err)
+		}
+		options = append(options, NewWeightedVoteOption(option, weight))
+	}
+	return options, options.Validate()
+}
+
 // Marshal needed for protobuf compatibility
 func (vo VoteOption) Marshal() ([]byte, error) {
 	return []byte{byte(vo)}, nil
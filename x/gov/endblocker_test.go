@@ -2,14 +2,26 @@ package gov
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/gov/tags"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+// returns the context of a block at the time elapsed since the block time of
+// the test contexts
+func withElapsedTime(ctx sdk.Context, elapsed time.Duration) sdk.Context {
+	header := ctx.BlockHeader()
+	header.Time = time.Time{}.Add(elapsed)
+	return ctx.WithBlockHeader(header)
+}
+
 func TestTickExpiredDepositPeriod(t *testing.T) {
 	mapp, keeper, _, addrs, _, _ := getMockApp(t, 10)
 	mapp.BeginBlock(abci.RequestBeginBlock{})
@@ -23,22 +35,33 @@ func TestTickExpiredDepositPeriod(t *testing.T) {
 
 	res := govHandler(ctx, newProposalMsg)
 	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
 
 	EndBlocker(ctx, keeper)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
 
-	ctx = ctx.WithBlockHeight(10)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
 	EndBlocker(ctx, keeper)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
 
-	ctx = ctx.WithBlockHeight(250)
+	depositsAddr := auth.NewModuleAddress(ModuleName)
+	require.Equal(t, int64(5), keeper.ck.GetCoins(ctx, depositsAddr).AmountOf("steak").Int64())
+
+	ctx = withElapsedTime(ctx, keeper.GetDepositProcedure(ctx).MaxDepositPeriod+time.Duration(50)*time.Second)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.True(t, shouldPopInactiveProposalQueue(ctx, keeper))
 	EndBlocker(ctx, keeper)
 	require.Nil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
+
+	// the deposits of the dropped proposal are burned
+	require.True(t, keeper.ck.GetCoins(ctx, depositsAddr).IsZero())
+	require.Equal(t, int64(37), keeper.ck.GetCoins(ctx, addrs[0]).AmountOf("steak").Int64())
+	_, found := keeper.GetDeposit(ctx, proposalID, addrs[0])
+	require.False(t, found)
 }
 
 func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
@@ -59,7 +82,7 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
 
-	ctx = ctx.WithBlockHeight(10)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
 	EndBlocker(ctx, keeper)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
@@ -68,14 +91,14 @@ func TestTickMultipleExpiredDepositPeriod(t *testing.T) {
 	res = govHandler(ctx, newProposalMsg2)
 	require.True(t, res.IsOK())
 
-	ctx = ctx.WithBlockHeight(205)
+	ctx = withElapsedTime(ctx, keeper.GetDepositProcedure(ctx).MaxDepositPeriod+time.Duration(5)*time.Second)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.True(t, shouldPopInactiveProposalQueue(ctx, keeper))
 	EndBlocker(ctx, keeper)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
 
-	ctx = ctx.WithBlockHeight(215)
+	ctx = withElapsedTime(ctx, keeper.GetDepositProcedure(ctx).MaxDepositPeriod+time.Duration(15)*time.Second)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.True(t, shouldPopInactiveProposalQueue(ctx, keeper))
 	EndBlocker(ctx, keeper)
@@ -105,7 +128,7 @@ func TestTickPassedDepositPeriod(t *testing.T) {
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
 
-	ctx = ctx.WithBlockHeight(10)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
 	EndBlocker(ctx, keeper)
 	require.NotNil(t, keeper.InactiveProposalQueuePeek(ctx))
 	require.False(t, shouldPopInactiveProposalQueue(ctx, keeper))
@@ -146,14 +169,14 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	var proposalID int64
 	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
 
-	ctx = ctx.WithBlockHeight(10)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
 	newDepositMsg := NewMsgDeposit(addrs[1], proposalID, sdk.Coins{sdk.NewInt64Coin("steak", 5)})
 	res = govHandler(ctx, newDepositMsg)
 	require.True(t, res.IsOK())
 
 	EndBlocker(ctx, keeper)
 
-	ctx = ctx.WithBlockHeight(215)
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
 	require.True(t, shouldPopActiveProposalQueue(ctx, keeper))
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
 	require.True(t, depositsIterator.Valid())
@@ -170,6 +193,42 @@ func TestTickPassedVotingPeriod(t *testing.T) {
 	require.True(t, keeper.GetProposal(ctx, proposalID).GetTallyResult().Equals(EmptyTallyResult()))
 }
 
+func TestDepositsBelowQuorum(t *testing.T) {
+	for _, burn := range []bool{true, false} {
+		mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+		mapp.BeginBlock(abci.RequestBeginBlock{})
+		ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+		govHandler := NewHandler(keeper)
+
+		tallyingProcedure := keeper.GetTallyingProcedure(ctx)
+		tallyingProcedure.BurnDepositsBelowQuorum = burn
+		keeper.setTallyingProcedure(ctx, tallyingProcedure)
+
+		newProposalMsg := NewMsgSubmitProposal("Test", "test", ProposalTypeText, addrs[0], sdk.Coins{sdk.NewInt64Coin("steak", 10)})
+		res := govHandler(ctx, newProposalMsg)
+		require.True(t, res.IsOK())
+		var proposalID int64
+		keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+		EndBlocker(ctx, keeper)
+
+		// nobody votes so the proposal does not reach quorum
+		looseTokens := sk.GetPool(ctx).LooseTokens
+		ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
+		EndBlocker(ctx, keeper)
+		require.Equal(t, StatusRejected, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+		// the deposits are burned or refunded as set by the tallying procedure,
+		// the burned deposits are removed from the loose tokens of the pool
+		require.True(t, keeper.ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).IsZero())
+		expBalance, expLooseTokens := int64(42), looseTokens
+		if burn {
+			expBalance, expLooseTokens = 32, looseTokens.Sub(sdk.NewDec(10))
+		}
+		require.Equal(t, expBalance, keeper.ck.GetCoins(ctx, addrs[0]).AmountOf("steak").Int64())
+		require.True(t, expLooseTokens.Equal(sk.GetPool(ctx).LooseTokens))
+	}
+}
+
 func TestSlashing(t *testing.T) {
 	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
 	SortAddresses(addrs)
@@ -192,7 +251,7 @@ func TestSlashing(t *testing.T) {
 	var proposalID int64
 	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
 
-	ctx = ctx.WithBlockHeight(10)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
 	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
 
 	newVoteMsg := NewMsgVote(addrs[0], proposalID, OptionYes)
@@ -201,7 +260,7 @@ func TestSlashing(t *testing.T) {
 
 	EndBlocker(ctx, keeper)
 
-	ctx = ctx.WithBlockHeight(215)
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
 	require.Equal(t, StatusVotingPeriod, keeper.GetProposal(ctx, proposalID).GetStatus())
 
 	EndBlocker(ctx, keeper)
@@ -217,3 +276,210 @@ func TestSlashing(t *testing.T) {
 	require.True(t, val1End.LT(val1Initial))
 	require.True(t, val2End.LT(val2Initial))
 }
+
+func TestCommunityPoolSpendProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:1], []int64{25})
+
+	// the community pool can not be spent to module accounts, even before they exist
+	newProposalMsg := NewMsgSubmitCommunityPoolSpendProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, auth.NewModuleAddress(ModuleName), sdk.Coins{sdk.NewInt64Coin("steak", 30)})
+	res := govHandler(ctx, newProposalMsg)
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidSpendRecipient), res.Code)
+
+	// the community pool is held by the distribution module account
+	distrKeeper := keeper.cpk.(distribution.Keeper)
+	distrKeeper.SetFeePool(ctx, distribution.FeePool{
+		CommunityPool: sdk.DecCoins{sdk.NewDecCoin("steak", 100)},
+	})
+	distrAcc := keeper.ck.GetModuleAccount(ctx, distribution.ModuleName, auth.Holder)
+	_, _, err := keeper.ck.AddCoins(ctx, distrAcc.GetAddress(), sdk.Coins{sdk.NewInt64Coin("steak", 100)})
+	require.Nil(t, err)
+
+	// the spend is only validated against the pool once the proposal passes
+	newProposalMsg = NewMsgSubmitCommunityPoolSpendProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 30)})
+	res = govHandler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*CommunityPoolSpendProposal)
+	require.True(t, ok)
+	require.Equal(t, addrs[1], proposal.Recipient)
+	require.Equal(t, ProposalTypeCommunityPoolSpend, proposal.GetProposalType())
+
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+	EndBlocker(ctx, keeper)
+
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+
+	// the recipient received the coins from the community pool
+	require.Equal(t, int64(72), keeper.ck.GetCoins(ctx, addrs[1]).AmountOf("steak").Int64())
+	expPool := sdk.DecCoins{sdk.NewDecCoin("steak", 70)}
+	require.True(t, expPool.IsEqual(distrKeeper.GetFeePool(ctx).CommunityPool))
+	require.Equal(t, int64(70), keeper.ck.GetCoins(ctx, distrAcc.GetAddress()).AmountOf("steak").Int64())
+}
+
+func TestProposalExecutionFailure(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:1], []int64{25})
+
+	// the community pool is empty, so the spend can not be executed
+	newProposalMsg := NewMsgSubmitCommunityPoolSpendProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, addrs[1], sdk.Coins{sdk.NewInt64Coin("steak", 30)})
+	res := govHandler(ctx, newProposalMsg)
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+	EndBlocker(ctx, keeper)
+
+	// the proposal passed but failed, the deposits are refunded
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
+	resTags := EndBlocker(ctx, keeper)
+	require.Equal(t, StatusFailed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	require.Equal(t, sdk.NewTags(
+		tags.Action, tags.ActionProposalFailed,
+		tags.ProposalID, keeper.cdc.MustMarshalBinaryBare(proposalID),
+	), resTags)
+	require.Equal(t, int64(42), keeper.ck.GetCoins(ctx, addrs[1]).AmountOf("steak").Int64())
+	require.True(t, keeper.ck.GetCoins(ctx, auth.NewModuleAddress(ModuleName)).IsZero())
+
+	// the failed proposal can be queried by its status
+	status, err := ProposalStatusFromString("Failed")
+	require.Nil(t, err)
+	require.Equal(t, StatusFailed, status)
+	require.Len(t, keeper.GetProposalsFiltered(ctx, nil, nil, StatusFailed, 0), 1)
+}
+
+func TestParameterChangeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:1], []int64{25})
+
+	// changes of unknown parameters or with invalid values are rejected
+	for _, change := range []ParamChange{
+		NewParamChange("gov", "unknown", `{}`),
+		NewParamChange("gov", "votingprocedure", `{"voting_period":"-10"}`),
+		NewParamChange("gov", "votingprocedure", `not json`),
+	} {
+		res := govHandler(ctx, NewMsgSubmitParameterChangeProposal("Test", "test", addrs[0],
+			sdk.Coins{sdk.NewInt64Coin("steak", 15)}, []ParamChange{change}))
+		require.False(t, res.IsOK())
+		require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidParamChange), res.Code)
+	}
+
+	changes := []ParamChange{NewParamChange("gov", "votingprocedure", `{"voting_period":"345600000000000"}`)}
+	res := govHandler(ctx, NewMsgSubmitParameterChangeProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, changes))
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*ParameterChangeProposal)
+	require.True(t, ok)
+	require.Equal(t, changes, proposal.Changes)
+	require.Equal(t, ProposalTypeParameterChange, proposal.GetProposalType())
+
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+	EndBlocker(ctx, keeper)
+
+	// the parameters are only changed once the proposal passes
+	require.Equal(t, time.Duration(172800)*time.Second, keeper.GetVotingProcedure(ctx).VotingPeriod)
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	require.Equal(t, time.Duration(345600)*time.Second, keeper.GetVotingProcedure(ctx).VotingPeriod)
+}
+
+func TestSoftwareUpgradeProposal(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	SortAddresses(addrs)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	govHandler := NewHandler(keeper)
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:1], []int64{25})
+
+	// plans for a height already reached are rejected
+	ctx = ctx.WithBlockHeight(5)
+	res := govHandler(ctx, NewMsgSubmitSoftwareUpgradeProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, NewPlan("v1", 5, "")))
+	require.False(t, res.IsOK())
+	require.Equal(t, sdk.ToABCICode(DefaultCodespace, CodeInvalidUpgradePlan), res.Code)
+
+	plan := NewPlan("v1", 300, "https://example.com/v1")
+	res = govHandler(ctx, NewMsgSubmitSoftwareUpgradeProposal("Test", "test", addrs[0],
+		sdk.Coins{sdk.NewInt64Coin("steak", 15)}, plan))
+	require.True(t, res.IsOK())
+	var proposalID int64
+	keeper.cdc.UnmarshalBinaryBare(res.Data, &proposalID)
+
+	proposal, ok := keeper.GetProposal(ctx, proposalID).(*SoftwareUpgradeProposal)
+	require.True(t, ok)
+	require.Equal(t, plan, proposal.Plan)
+
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
+	res = govHandler(ctx, NewMsgVote(addrs[0], proposalID, OptionYes))
+	require.True(t, res.IsOK())
+	EndBlocker(ctx, keeper)
+
+	// the plan is only scheduled once the proposal passes
+	_, found := keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	ctx = withElapsedTime(ctx, keeper.GetVotingProcedure(ctx).VotingPeriod+time.Duration(15)*time.Second)
+	EndBlocker(ctx, keeper)
+	require.Equal(t, StatusPassed, keeper.GetProposal(ctx, proposalID).GetStatus())
+	scheduled, found := keeper.GetUpgradePlan(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, scheduled)
+
+	// the chain runs until the height of the plan, where it halts unless a
+	// handler is registered for it
+	BeginBlocker(ctx.WithBlockHeight(299), keeper)
+	require.Panics(t, func() { BeginBlocker(ctx.WithBlockHeight(300), keeper) })
+
+	var applied Plan
+	upgradedKeeper := keeper.WithUpgradeHandler("v1", func(ctx sdk.Context, plan Plan) { applied = plan })
+	ctx = ctx.WithBlockHeight(300)
+	BeginBlocker(ctx, upgradedKeeper)
+	require.Equal(t, plan, applied)
+	_, found = keeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	lastApplied, found := keeper.GetLastAppliedUpgrade(ctx)
+	require.True(t, found)
+	require.Equal(t, plan, lastApplied)
+
+	// the handler runs once
+	applied = Plan{}
+	BeginBlocker(ctx.WithBlockHeight(301), upgradedKeeper)
+	require.Equal(t, Plan{}, applied)
+}
@@ -21,6 +21,10 @@ const (
 	CodeInvalidVote             sdk.CodeType = 9
 	CodeInvalidGenesis          sdk.CodeType = 10
 	CodeInvalidProposalStatus   sdk.CodeType = 11
+	CodeInvalidParamChange      sdk.CodeType = 12
+	CodeInvalidUpgradePlan      sdk.CodeType = 13
+	CodeNoUpgradePlan           sdk.CodeType = 14
+	CodeInvalidSpendRecipient   sdk.CodeType = 15
 )
 
 //----------------------------------------
@@ -62,6 +66,34 @@ func ErrInvalidVote(codespace sdk.CodespaceType, voteOption VoteOption) sdk.Erro
 	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%v' is not a valid voting option", voteOption))
 }
 
+func ErrInvalidWeightedVote(codespace sdk.CodespaceType, options WeightedVoteOptions, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVote, fmt.Sprintf("'%s' are not valid weighted voting options: %s", options, err))
+}
+
+func ErrInvalidParamChange(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, msg)
+}
+
+func ErrUnknownParam(codespace sdk.CodespaceType, change ParamChange) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, fmt.Sprintf("Parameter '%s' of module '%s' can not be changed by proposals", change.Key, change.Module))
+}
+
+func ErrInvalidParamValue(codespace sdk.CodespaceType, change ParamChange, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParamChange, fmt.Sprintf("Invalid value of parameter '%s' of module '%s': %s", change.Key, change.Module, err.Error()))
+}
+
+func ErrInvalidUpgradePlan(codespace sdk.CodespaceType, msg string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidUpgradePlan, msg)
+}
+
+func ErrNoUpgradePlan(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoUpgradePlan, "No upgrade plan found")
+}
+
+func ErrSpendToModuleAccount(codespace sdk.CodespaceType, recipient sdk.AccAddress) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSpendRecipient, fmt.Sprintf("Community pool spend recipient %s is a module account", recipient))
+}
+
 func ErrInvalidGenesis(codespace sdk.CodespaceType, msg string) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidVote, msg)
 }
@@ -17,6 +17,8 @@ func NewHandler(keeper Keeper) sdk.Handler {
 			return handleMsgSubmitProposal(ctx, keeper, msg)
 		case MsgVote:
 			return handleMsgVote(ctx, keeper, msg)
+		case MsgVoteWeighted:
+			return handleMsgVoteWeighted(ctx, keeper, msg)
 		default:
 			errMsg := "Unrecognized gov msg type"
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -26,7 +28,27 @@ func NewHandler(keeper Keeper) sdk.Handler {
 
 func handleMsgSubmitProposal(ctx sdk.Context, keeper Keeper, msg MsgSubmitProposal) sdk.Result {
 
-	proposal := keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	var proposal Proposal
+	if msg.ProposalType == ProposalTypeCommunityPoolSpend {
+		if keeper.ck.IsModuleAccount(ctx, msg.Recipient) {
+			return ErrSpendToModuleAccount(keeper.codespace, msg.Recipient).Result()
+		}
+		proposal = keeper.NewCommunityPoolSpendProposal(ctx, msg.Title, msg.Description, msg.Recipient, msg.Amount)
+	} else if msg.ProposalType == ProposalTypeParameterChange {
+		err := keeper.ValidateParamChanges(msg.Changes)
+		if err != nil {
+			return err.Result()
+		}
+		proposal = keeper.NewParameterChangeProposal(ctx, msg.Title, msg.Description, msg.Changes)
+	} else if msg.ProposalType == ProposalTypeSoftwareUpgrade {
+		if msg.Plan.Height <= ctx.BlockHeight() {
+			return ErrInvalidUpgradePlan(keeper.codespace,
+				fmt.Sprintf("Upgrade plan height %d has already been reached", msg.Plan.Height)).Result()
+		}
+		proposal = keeper.NewSoftwareUpgradeProposal(ctx, msg.Title, msg.Description, msg.Plan)
+	} else {
+		proposal = keeper.NewTextProposal(ctx, msg.Title, msg.Description, msg.ProposalType)
+	}
 
 	err, votingStarted := keeper.AddDeposit(ctx, proposal.GetProposalID(), msg.Proposer, msg.InitialDeposit)
 	if err != nil {
@@ -95,6 +117,25 @@ func handleMsgVote(ctx sdk.Context, keeper Keeper, msg MsgVote) sdk.Result {
 	}
 }
 
+func handleMsgVoteWeighted(ctx sdk.Context, keeper Keeper, msg MsgVoteWeighted) sdk.Result {
+
+	err := keeper.AddWeightedVote(ctx, msg.ProposalID, msg.Voter, msg.Options)
+	if err != nil {
+		return err.Result()
+	}
+
+	proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(msg.ProposalID)
+
+	resTags := sdk.NewTags(
+		tags.Action, tags.ActionVote,
+		tags.Voter, []byte(msg.Voter.String()),
+		tags.ProposalID, proposalIDBytes,
+	)
+	return sdk.Result{
+		Tags: resTags,
+	}
+}
+
 // Called every block, process inflation, update validator set
 func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 
@@ -110,11 +151,12 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 		}
 
 		proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(inactiveProposal.GetProposalID())
+		keeper.DeleteDeposits(ctx, inactiveProposal.GetProposalID())
 		keeper.DeleteProposal(ctx, inactiveProposal)
-		resTags.AppendTag(tags.Action, tags.ActionProposalDropped)
-		resTags.AppendTag(tags.ProposalID, proposalIDBytes)
+		resTags = resTags.AppendTag(tags.Action, tags.ActionProposalDropped)
+		resTags = resTags.AppendTag(tags.ProposalID, proposalIDBytes)
 
-		logger.Info("Proposal %d - \"%s\" - didn't mean minimum deposit (had only %s), deleted",
+		logger.Info("Proposal %d - \"%s\" - didn't mean minimum deposit (had only %s), deleted and deposits burned",
 			inactiveProposal.GetProposalID(), inactiveProposal.GetTitle(), inactiveProposal.GetTotalDeposit())
 	}
 
@@ -122,21 +164,30 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 	for shouldPopActiveProposalQueue(ctx, keeper) {
 		activeProposal := keeper.ActiveProposalQueuePop(ctx)
 
-		proposalStartBlock := activeProposal.GetVotingStartBlock()
-		votingPeriod := keeper.GetVotingProcedure(ctx).VotingPeriod
-		if ctx.BlockHeight() < proposalStartBlock+votingPeriod {
-			continue
-		}
-
 		passes, tallyResults, nonVotingVals := tally(ctx, keeper, activeProposal)
 		proposalIDBytes := keeper.cdc.MustMarshalBinaryBare(activeProposal.GetProposalID())
 		var action []byte
 		if passes {
 			keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
-			activeProposal.SetStatus(StatusPassed)
-			action = tags.ActionProposalPassed
+			err := executeProposal(ctx, keeper, activeProposal)
+			if err != nil {
+				// the proposal passed but what it proposes could not be done
+				activeProposal.SetStatus(StatusFailed)
+				action = tags.ActionProposalFailed
+				logger.Info(fmt.Sprintf("Proposal %d - \"%s\" - passed but failed to execute: %s",
+					activeProposal.GetProposalID(), activeProposal.GetTitle(), err.Error()))
+			} else {
+				activeProposal.SetStatus(StatusPassed)
+				action = tags.ActionProposalPassed
+			}
 		} else {
-			keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
+			// the deposits of proposals which did not reach quorum may be refunded
+			tallyingProcedure := keeper.GetTallyingProcedure(ctx)
+			if tallyResults.Turnout.LT(tallyingProcedure.Quorum) && !tallyingProcedure.BurnDepositsBelowQuorum {
+				keeper.RefundDeposits(ctx, activeProposal.GetProposalID())
+			} else {
+				keeper.DeleteDeposits(ctx, activeProposal.GetProposalID())
+			}
 			activeProposal.SetStatus(StatusRejected)
 			action = tags.ActionProposalRejected
 		}
@@ -158,34 +209,63 @@ func EndBlocker(ctx sdk.Context, keeper Keeper) (resTags sdk.Tags) {
 				val.GetOperator(), activeProposal.GetProposalID()))
 		}
 
-		resTags.AppendTag(tags.Action, action)
-		resTags.AppendTag(tags.ProposalID, proposalIDBytes)
+		resTags = resTags.AppendTag(tags.Action, action)
+		resTags = resTags.AppendTag(tags.ProposalID, proposalIDBytes)
 	}
 
 	return resTags
 }
 func shouldPopInactiveProposalQueue(ctx sdk.Context, keeper Keeper) bool {
-	depositProcedure := keeper.GetDepositProcedure(ctx)
 	peekProposal := keeper.InactiveProposalQueuePeek(ctx)
 
 	if peekProposal == nil {
 		return false
 	} else if peekProposal.GetStatus() != StatusDepositPeriod {
 		return true
-	} else if ctx.BlockHeight() >= peekProposal.GetSubmitBlock()+depositProcedure.MaxDepositPeriod {
+	} else if !ctx.BlockHeader().Time.Before(peekProposal.GetDepositEndTime()) {
 		return true
 	}
 	return false
 }
 
 func shouldPopActiveProposalQueue(ctx sdk.Context, keeper Keeper) bool {
-	votingProcedure := keeper.GetVotingProcedure(ctx)
 	peekProposal := keeper.ActiveProposalQueuePeek(ctx)
 
 	if peekProposal == nil {
 		return false
-	} else if ctx.BlockHeight() >= peekProposal.GetVotingStartBlock()+votingProcedure.VotingPeriod {
+	} else if !ctx.BlockHeader().Time.Before(peekProposal.GetVotingEndTime()) {
 		return true
 	}
 	return false
 }
+
+// Executes a passed proposal, spending from the community pool, changing the
+// parameters or scheduling the upgrade it proposes
+func executeProposal(ctx sdk.Context, keeper Keeper, proposal Proposal) sdk.Error {
+	logger := ctx.Logger().With("module", "x/gov")
+
+	switch proposal := proposal.(type) {
+	case *CommunityPoolSpendProposal:
+		err := keeper.cpk.DistributeFromCommunityPool(ctx, proposal.Amount, proposal.Recipient)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Proposal %d - sent %s from the community pool to %s",
+			proposal.GetProposalID(), proposal.Amount, proposal.Recipient))
+	case *ParameterChangeProposal:
+		err := keeper.applyParamChanges(ctx, proposal.Changes)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Proposal %d - changed parameters %v",
+			proposal.GetProposalID(), proposal.Changes))
+	case *SoftwareUpgradeProposal:
+		err := keeper.ScheduleUpgrade(ctx, proposal.Plan)
+		if err != nil {
+			return err
+		}
+		logger.Info(fmt.Sprintf("Proposal %d - scheduled %v",
+			proposal.GetProposalID(), proposal.Plan))
+	}
+	return nil
+}
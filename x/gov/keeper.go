@@ -3,10 +3,14 @@ package gov
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	wire "github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
+// name of the module account holding the proposal deposits
+const ModuleName = "gov"
+
 // nolint
 const (
 	ParamStoreKeyDepositProcedure  = "gov/depositprocedure"
@@ -28,6 +32,19 @@ type Keeper struct {
 	// The reference to the DelegationSet to get information about delegators
 	ds sdk.DelegationSet
 
+	// The reference to the StakeKeeper to remove burned deposits from the pool
+	sk StakeKeeper
+
+	// The reference to the CommunityPoolKeeper to spend from the community pool
+	cpk CommunityPoolKeeper
+
+	// The validators of the parameters which parameter change proposals can
+	// change, by key in the global param store
+	changeableParams map[string]ParamValidator
+
+	// The handlers migrating the state for upgrade plans, by plan name
+	upgradeHandlers map[string]UpgradeHandler
+
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 
@@ -38,17 +55,33 @@ type Keeper struct {
 	codespace sdk.CodespaceType
 }
 
+// Spends coins from the community pool, implemented by the distribution keeper
+type CommunityPoolKeeper interface {
+	DistributeFromCommunityPool(ctx sdk.Context, amount sdk.Coins, recipient sdk.AccAddress) sdk.Error
+}
+
+// Gets information about delegators and keeps the loose tokens of the pool in
+// sync with the burned deposits, implemented by the stake keeper
+type StakeKeeper interface {
+	sdk.DelegationSet
+	BondDenom(ctx sdk.Context) string
+	BurnLooseTokens(ctx sdk.Context, burnedTokens sdk.Dec)
+}
+
 // NewGovernanceMapper returns a mapper that uses go-wire to (binary) encode and decode gov types.
-func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ps params.Setter, ck bank.Keeper, ds sdk.DelegationSet, codespace sdk.CodespaceType) Keeper {
-	return Keeper{
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ps params.Setter, ck bank.Keeper, sk StakeKeeper, cpk CommunityPoolKeeper, codespace sdk.CodespaceType) Keeper {
+	keeper := Keeper{
 		storeKey:  key,
 		ps:        ps,
 		ck:        ck,
-		ds:        ds,
-		vs:        ds.GetValidatorSet(),
+		ds:        sk,
+		sk:        sk,
+		vs:        sk.GetValidatorSet(),
+		cpk:       cpk,
 		cdc:       cdc,
 		codespace: codespace,
 	}
+	return keeper.WithChangeableParams(defaultChangeableParams()...)
 }
 
 // Returns the go-wire codec.
@@ -65,16 +98,76 @@ func (keeper Keeper) NewTextProposal(ctx sdk.Context, title string, description
 	if err != nil {
 		return nil
 	}
-	var proposal Proposal = &TextProposal{
-		ProposalID:       proposalID,
-		Title:            title,
-		Description:      description,
-		ProposalType:     proposalType,
-		Status:           StatusDepositPeriod,
-		TallyResult:      EmptyTallyResult(),
-		TotalDeposit:     sdk.Coins{},
-		SubmitBlock:      ctx.BlockHeight(),
-		VotingStartBlock: -1, // TODO: Make Time
+	textProposal := keeper.newTextProposal(ctx, proposalID, title, description, proposalType)
+	var proposal Proposal = &textProposal
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal
+}
+
+// The text of a new proposal, in its deposit period until the max deposit
+// period has passed
+func (keeper Keeper) newTextProposal(ctx sdk.Context, proposalID int64, title string, description string,
+	proposalType ProposalKind) TextProposal {
+
+	submitTime := ctx.BlockHeader().Time
+	return TextProposal{
+		ProposalID:     proposalID,
+		Title:          title,
+		Description:    description,
+		ProposalType:   proposalType,
+		Status:         StatusDepositPeriod,
+		TallyResult:    EmptyTallyResult(),
+		TotalDeposit:   sdk.Coins{},
+		SubmitTime:     submitTime,
+		DepositEndTime: submitTime.Add(keeper.GetDepositProcedure(ctx).MaxDepositPeriod),
+	}
+}
+
+// Creates a new proposal to send coins from the community pool to a recipient
+func (keeper Keeper) NewCommunityPoolSpendProposal(ctx sdk.Context, title string, description string,
+	recipient sdk.AccAddress, amount sdk.Coins) Proposal {
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var proposal Proposal = &CommunityPoolSpendProposal{
+		TextProposal: keeper.newTextProposal(ctx, proposalID, title, description, ProposalTypeCommunityPoolSpend),
+		Recipient:    recipient,
+		Amount:       amount,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal
+}
+
+// Creates a new proposal to change parameters
+func (keeper Keeper) NewParameterChangeProposal(ctx sdk.Context, title string, description string,
+	changes []ParamChange) Proposal {
+
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var proposal Proposal = &ParameterChangeProposal{
+		TextProposal: keeper.newTextProposal(ctx, proposalID, title, description, ProposalTypeParameterChange),
+		Changes:      changes,
+	}
+	keeper.SetProposal(ctx, proposal)
+	keeper.InactiveProposalQueuePush(ctx, proposal)
+	return proposal
+}
+
+// Creates a new proposal to upgrade the software
+func (keeper Keeper) NewSoftwareUpgradeProposal(ctx sdk.Context, title string, description string, plan Plan) Proposal {
+	proposalID, err := keeper.getNewProposalID(ctx)
+	if err != nil {
+		return nil
+	}
+	var proposal Proposal = &SoftwareUpgradeProposal{
+		TextProposal: keeper.newTextProposal(ctx, proposalID, title, description, ProposalTypeSoftwareUpgrade),
+		Plan:         plan,
 	}
 	keeper.SetProposal(ctx, proposal)
 	keeper.InactiveProposalQueuePush(ctx, proposal)
@@ -200,7 +293,9 @@ func (keeper Keeper) peekCurrentProposalID(ctx sdk.Context) (proposalID int64, e
 }
 
 func (keeper Keeper) activateVotingPeriod(ctx sdk.Context, proposal Proposal) {
-	proposal.SetVotingStartBlock(ctx.BlockHeight())
+	votingStartTime := ctx.BlockHeader().Time
+	proposal.SetVotingStartTime(votingStartTime)
+	proposal.SetVotingEndTime(votingStartTime.Add(keeper.GetVotingProcedure(ctx).VotingPeriod))
 	proposal.SetStatus(StatusVotingPeriod)
 	keeper.SetProposal(ctx, proposal)
 	keeper.ActiveProposalQueuePush(ctx, proposal)
@@ -245,8 +340,17 @@ func (keeper Keeper) setTallyingProcedure(ctx sdk.Context, tallyingProcedure Tal
 // =====================================================
 // Votes
 
-// Adds a vote on a specific proposal
+// Adds a vote on a specific proposal, giving all the voting power to one option
 func (keeper Keeper) AddVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress, option VoteOption) sdk.Error {
+	if !validVoteOption(option) {
+		return ErrInvalidVote(keeper.codespace, option)
+	}
+	return keeper.AddWeightedVote(ctx, proposalID, voterAddr, NewNonSplitVoteOption(option))
+}
+
+// Adds a vote on a specific proposal, splitting the voting power across
+// options
+func (keeper Keeper) AddWeightedVote(ctx sdk.Context, proposalID int64, voterAddr sdk.AccAddress, options WeightedVoteOptions) sdk.Error {
 	proposal := keeper.GetProposal(ctx, proposalID)
 	if proposal == nil {
 		return ErrUnknownProposal(keeper.codespace, proposalID)
@@ -255,14 +359,15 @@ func (keeper Keeper) AddVote(ctx sdk.Context, proposalID int64, voterAddr sdk.Ac
 		return ErrInactiveProposal(keeper.codespace, proposalID)
 	}
 
-	if !validVoteOption(option) {
-		return ErrInvalidVote(keeper.codespace, option)
+	err := options.Validate()
+	if err != nil {
+		return ErrInvalidWeightedVote(keeper.codespace, options, err)
 	}
 
 	vote := Vote{
 		ProposalID: proposalID,
 		Voter:      voterAddr,
-		Option:     option,
+		Options:    options,
 	}
 	keeper.setVote(ctx, proposalID, voterAddr, vote)
 
@@ -333,8 +438,9 @@ func (keeper Keeper) AddDeposit(ctx sdk.Context, proposalID int64, depositerAddr
 		return ErrAlreadyFinishedProposal(keeper.codespace, proposalID), false
 	}
 
-	// Subtract coins from depositer's account
-	_, _, err := keeper.ck.SubtractCoins(ctx, depositerAddr, depositAmount)
+	// Send coins from depositer's account to the gov module account
+	depositsAcc := keeper.ck.GetModuleAccount(ctx, ModuleName, auth.Burner)
+	_, err := keeper.ck.SendCoins(ctx, depositerAddr, depositsAcc.GetAddress(), depositAmount)
 	if err != nil {
 		return err, false
 	}
@@ -374,12 +480,13 @@ func (keeper Keeper) GetDeposits(ctx sdk.Context, proposalID int64) sdk.Iterator
 func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
+	depositsAcc := keeper.ck.GetModuleAccount(ctx, ModuleName, auth.Burner)
 
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
 		deposit := &Deposit{}
 		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
 
-		_, _, err := keeper.ck.AddCoins(ctx, deposit.Depositer, deposit.Amount)
+		_, err := keeper.ck.SendCoins(ctx, depositsAcc.GetAddress(), deposit.Depositer, deposit.Amount)
 		if err != nil {
 			panic("should not happen")
 		}
@@ -390,108 +497,92 @@ func (keeper Keeper) RefundDeposits(ctx sdk.Context, proposalID int64) {
 	depositsIterator.Close()
 }
 
-// Deletes all the deposits on a specific proposal without refunding them
+// Deletes all the deposits on a specific proposal without refunding them,
+// burning them from the gov module account and from the loose tokens of the
+// pool
 func (keeper Keeper) DeleteDeposits(ctx sdk.Context, proposalID int64) {
 	store := ctx.KVStore(keeper.storeKey)
 	depositsIterator := keeper.GetDeposits(ctx, proposalID)
+	bondDenom := keeper.sk.BondDenom(ctx)
+	burnedTokens := sdk.ZeroInt()
 
 	for ; depositsIterator.Valid(); depositsIterator.Next() {
+		deposit := &Deposit{}
+		keeper.cdc.MustUnmarshalBinary(depositsIterator.Value(), deposit)
+
+		_, err := keeper.ck.BurnCoins(ctx, ModuleName, deposit.Amount)
+		if err != nil {
+			panic("should not happen")
+		}
+		burnedTokens = burnedTokens.Add(deposit.Amount.AmountOf(bondDenom))
+
 		store.Delete(depositsIterator.Key())
 	}
 
 	depositsIterator.Close()
+
+	if !burnedTokens.IsZero() {
+		keeper.sk.BurnLooseTokens(ctx, sdk.NewDecFromInt(burnedTokens))
+	}
 }
 
 // =====================================================
 // ProposalQueues
 
-func (keeper Keeper) getActiveProposalQueue(ctx sdk.Context) ProposalQueue {
+// Return the Proposal of the ProposalQueue under the prefix whose period ends first
+func (keeper Keeper) proposalQueuePeek(ctx sdk.Context, prefix []byte) (proposal Proposal, key []byte) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := store.Get(KeyActiveProposalQueue)
-	if bz == nil {
-		return nil
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, nil
 	}
 
-	var proposalQueue ProposalQueue
-	keeper.cdc.MustUnmarshalBinary(bz, &proposalQueue)
-
-	return proposalQueue
-}
-
-func (keeper Keeper) setActiveProposalQueue(ctx sdk.Context, proposalQueue ProposalQueue) {
-	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinary(proposalQueue)
-	store.Set(KeyActiveProposalQueue, bz)
+	var proposalID int64
+	keeper.cdc.MustUnmarshalBinary(iterator.Value(), &proposalID)
+	return keeper.GetProposal(ctx, proposalID), append([]byte{}, iterator.Key()...)
 }
 
-// Return the Proposal at the front of the ProposalQueue
+// Return the Proposal whose voting period ends first
 func (keeper Keeper) ActiveProposalQueuePeek(ctx sdk.Context) Proposal {
-	proposalQueue := keeper.getActiveProposalQueue(ctx)
-	if len(proposalQueue) == 0 {
-		return nil
-	}
-	return keeper.GetProposal(ctx, proposalQueue[0])
+	proposal, _ := keeper.proposalQueuePeek(ctx, KeyActiveProposalQueue)
+	return proposal
 }
 
-// Remove and return a Proposal from the front of the ProposalQueue
+// Remove and return the Proposal whose voting period ends first
 func (keeper Keeper) ActiveProposalQueuePop(ctx sdk.Context) Proposal {
-	proposalQueue := keeper.getActiveProposalQueue(ctx)
-	if len(proposalQueue) == 0 {
-		return nil
+	proposal, key := keeper.proposalQueuePeek(ctx, KeyActiveProposalQueue)
+	if key != nil {
+		ctx.KVStore(keeper.storeKey).Delete(key)
 	}
-	frontElement, proposalQueue := proposalQueue[0], proposalQueue[1:]
-	keeper.setActiveProposalQueue(ctx, proposalQueue)
-	return keeper.GetProposal(ctx, frontElement)
+	return proposal
 }
 
-// Add a proposalID to the back of the ProposalQueue
+// Add a Proposal to the ProposalQueue, ordered by the end of its voting period
 func (keeper Keeper) ActiveProposalQueuePush(ctx sdk.Context, proposal Proposal) {
-	proposalQueue := append(keeper.getActiveProposalQueue(ctx), proposal.GetProposalID())
-	keeper.setActiveProposalQueue(ctx, proposalQueue)
-}
-
-func (keeper Keeper) getInactiveProposalQueue(ctx sdk.Context) ProposalQueue {
-	store := ctx.KVStore(keeper.storeKey)
-	bz := store.Get(KeyInactiveProposalQueue)
-	if bz == nil {
-		return nil
-	}
-
-	var proposalQueue ProposalQueue
-
-	keeper.cdc.MustUnmarshalBinary(bz, &proposalQueue)
-
-	return proposalQueue
-}
-
-func (keeper Keeper) setInactiveProposalQueue(ctx sdk.Context, proposalQueue ProposalQueue) {
 	store := ctx.KVStore(keeper.storeKey)
-	bz := keeper.cdc.MustMarshalBinary(proposalQueue)
-	store.Set(KeyInactiveProposalQueue, bz)
+	bz := keeper.cdc.MustMarshalBinary(proposal.GetProposalID())
+	store.Set(KeyActiveProposalQueueProposal(proposal.GetVotingEndTime(), proposal.GetProposalID()), bz)
 }
 
-// Return the Proposal at the front of the ProposalQueue
+// Return the Proposal whose deposit period ends first
 func (keeper Keeper) InactiveProposalQueuePeek(ctx sdk.Context) Proposal {
-	proposalQueue := keeper.getInactiveProposalQueue(ctx)
-	if len(proposalQueue) == 0 {
-		return nil
-	}
-	return keeper.GetProposal(ctx, proposalQueue[0])
+	proposal, _ := keeper.proposalQueuePeek(ctx, KeyInactiveProposalQueue)
+	return proposal
 }
 
-// Remove and return a Proposal from the front of the ProposalQueue
+// Remove and return the Proposal whose deposit period ends first
 func (keeper Keeper) InactiveProposalQueuePop(ctx sdk.Context) Proposal {
-	proposalQueue := keeper.getInactiveProposalQueue(ctx)
-	if len(proposalQueue) == 0 {
-		return nil
+	proposal, key := keeper.proposalQueuePeek(ctx, KeyInactiveProposalQueue)
+	if key != nil {
+		ctx.KVStore(keeper.storeKey).Delete(key)
 	}
-	frontElement, proposalQueue := proposalQueue[0], proposalQueue[1:]
-	keeper.setInactiveProposalQueue(ctx, proposalQueue)
-	return keeper.GetProposal(ctx, frontElement)
+	return proposal
 }
 
-// Add a proposalID to the back of the ProposalQueue
+// Add a Proposal to the ProposalQueue, ordered by the end of its deposit period
 func (keeper Keeper) InactiveProposalQueuePush(ctx sdk.Context, proposal Proposal) {
-	proposalQueue := append(keeper.getInactiveProposalQueue(ctx), proposal.GetProposalID())
-	keeper.setInactiveProposalQueue(ctx, proposalQueue)
+	store := ctx.KVStore(keeper.storeKey)
+	bz := keeper.cdc.MustMarshalBinary(proposal.GetProposalID())
+	store.Set(KeyInactiveProposalQueueProposal(proposal.GetDepositEndTime(), proposal.GetProposalID()), bz)
 }
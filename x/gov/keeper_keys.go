@@ -2,6 +2,7 @@ package gov
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -11,8 +12,10 @@ import (
 // Key for getting a the next available proposalID from the store
 var (
 	KeyNextProposalID        = []byte("newProposalID")
-	KeyActiveProposalQueue   = []byte("activeProposalQueue")
-	KeyInactiveProposalQueue = []byte("inactiveProposalQueue")
+	KeyActiveProposalQueue   = []byte("activeProposalQueue:")
+	KeyInactiveProposalQueue = []byte("inactiveProposalQueue:")
+	KeyUpgradePlan           = []byte("upgradePlan")
+	KeyLastAppliedUpgrade    = []byte("lastAppliedUpgrade")
 )
 
 // Key for getting a specific proposal from the store
@@ -39,3 +42,18 @@ func KeyDepositsSubspace(proposalID int64) []byte {
 func KeyVotesSubspace(proposalID int64) []byte {
 	return []byte(fmt.Sprintf("votes:%d:", proposalID))
 }
+
+// Format of the end times in the proposal queue keys, which sort in time order
+const queueTimeFormat = "2006-01-02T15:04:05.000000000"
+
+// Key for getting a specific proposal from the active proposal queue, ordered
+// by the end time of its voting period
+func KeyActiveProposalQueueProposal(endTime time.Time, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("activeProposalQueue:%s:%020d", endTime.UTC().Format(queueTimeFormat), proposalID))
+}
+
+// Key for getting a specific proposal from the inactive proposal queue,
+// ordered by the end time of its deposit period
+func KeyInactiveProposalQueueProposal(endTime time.Time, proposalID int64) []byte {
+	return []byte(fmt.Sprintf("inactiveProposalQueue:%s:%020d", endTime.UTC().Format(queueTimeFormat), proposalID))
+}
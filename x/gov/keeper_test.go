@@ -2,12 +2,14 @@ package gov
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 )
 
 func TestGetSetProposal(t *testing.T) {
@@ -45,12 +47,14 @@ func TestActivateVotingPeriod(t *testing.T) {
 
 	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
 
-	require.Equal(t, int64(-1), proposal.GetVotingStartBlock())
+	require.True(t, proposal.GetVotingStartTime().IsZero())
+	require.Equal(t, ctx.BlockHeader().Time.Add(keeper.GetDepositProcedure(ctx).MaxDepositPeriod), proposal.GetDepositEndTime())
 	require.Nil(t, keeper.ActiveProposalQueuePeek(ctx))
 
 	keeper.activateVotingPeriod(ctx, proposal)
 
-	require.Equal(t, proposal.GetVotingStartBlock(), ctx.BlockHeight())
+	require.Equal(t, ctx.BlockHeader().Time, proposal.GetVotingStartTime())
+	require.Equal(t, ctx.BlockHeader().Time.Add(keeper.GetVotingProcedure(ctx).VotingPeriod), proposal.GetVotingEndTime())
 	require.Equal(t, proposal.GetProposalID(), keeper.ActiveProposalQueuePeek(ctx).GetProposalID())
 }
 
@@ -77,7 +81,7 @@ func TestDeposits(t *testing.T) {
 	// Check no deposits at beginning
 	deposit, found := keeper.GetDeposit(ctx, proposalID, addrs[1])
 	require.False(t, found)
-	require.Equal(t, keeper.GetProposal(ctx, proposalID).GetVotingStartBlock(), int64(-1))
+	require.True(t, keeper.GetProposal(ctx, proposalID).GetVotingStartTime().IsZero())
 	require.Nil(t, keeper.ActiveProposalQueuePeek(ctx))
 
 	// Check first deposit
@@ -113,8 +117,12 @@ func TestDeposits(t *testing.T) {
 	require.Equal(t, fourSteak.Plus(fiveSteak).Plus(fourSteak), keeper.GetProposal(ctx, proposalID).GetTotalDeposit())
 	require.Equal(t, addr1Initial.Minus(fourSteak), keeper.ck.GetCoins(ctx, addrs[1]))
 
+	// Check that the gov module account holds the deposits
+	depositsAddr := auth.NewModuleAddress(ModuleName)
+	require.Equal(t, fourSteak.Plus(fiveSteak).Plus(fourSteak), keeper.ck.GetCoins(ctx, depositsAddr))
+
 	// Check that proposal moved to voting period
-	require.Equal(t, ctx.BlockHeight(), keeper.GetProposal(ctx, proposalID).GetVotingStartBlock())
+	require.Equal(t, ctx.BlockHeader().Time, keeper.GetProposal(ctx, proposalID).GetVotingStartTime())
 	require.NotNil(t, keeper.ActiveProposalQueuePeek(ctx))
 	require.Equal(t, proposalID, keeper.ActiveProposalQueuePeek(ctx).GetProposalID())
 
@@ -141,7 +149,18 @@ func TestDeposits(t *testing.T) {
 	require.False(t, found)
 	require.Equal(t, addr0Initial, keeper.ck.GetCoins(ctx, addrs[0]))
 	require.Equal(t, addr1Initial, keeper.ck.GetCoins(ctx, addrs[1]))
+	require.True(t, keeper.ck.GetCoins(ctx, depositsAddr).IsZero())
 
+	// Test Delete Deposits burns them
+	proposal = keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID = proposal.GetProposalID()
+	err, _ = keeper.AddDeposit(ctx, proposalID, addrs[0], fourSteak)
+	require.Nil(t, err)
+	keeper.DeleteDeposits(ctx, proposalID)
+	_, found = keeper.GetDeposit(ctx, proposalID, addrs[0])
+	require.False(t, found)
+	require.Equal(t, addr0Initial.Minus(fourSteak), keeper.ck.GetCoins(ctx, addrs[0]))
+	require.True(t, keeper.ck.GetCoins(ctx, depositsAddr).IsZero())
 }
 
 func TestVotes(t *testing.T) {
@@ -162,7 +181,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionAbstain, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionAbstain).Equals(vote.Options))
 
 	// Test change of vote
 	keeper.AddVote(ctx, proposalID, addrs[0], OptionYes)
@@ -170,7 +189,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionYes, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionYes).Equals(vote.Options))
 
 	// Test second vote
 	keeper.AddVote(ctx, proposalID, addrs[1], OptionNoWithVeto)
@@ -178,7 +197,7 @@ func TestVotes(t *testing.T) {
 	require.True(t, found)
 	require.Equal(t, addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionNoWithVeto).Equals(vote.Options))
 
 	// Test vote iterator
 	votesIterator := keeper.GetVotes(ctx, proposalID)
@@ -187,14 +206,14 @@ func TestVotes(t *testing.T) {
 	require.True(t, votesIterator.Valid())
 	require.Equal(t, addrs[0], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionYes, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionYes).Equals(vote.Options))
 	votesIterator.Next()
 	require.True(t, votesIterator.Valid())
 	keeper.cdc.MustUnmarshalBinary(votesIterator.Value(), &vote)
 	require.True(t, votesIterator.Valid())
 	require.Equal(t, addrs[1], vote.Voter)
 	require.Equal(t, proposalID, vote.ProposalID)
-	require.Equal(t, OptionNoWithVeto, vote.Option)
+	require.True(t, NewNonSplitVoteOption(OptionNoWithVeto).Equals(vote.Options))
 	votesIterator.Next()
 	require.False(t, votesIterator.Valid())
 	votesIterator.Close()
@@ -247,3 +266,22 @@ func TestProposalQueues(t *testing.T) {
 	require.Equal(t, keeper.ActiveProposalQueuePeek(ctx).GetProposalID(), proposal4.GetProposalID())
 	require.Equal(t, keeper.ActiveProposalQueuePop(ctx).GetProposalID(), proposal4.GetProposalID())
 }
+
+func TestProposalQueuesOrderedByEndTime(t *testing.T) {
+	mapp, keeper, _, _, _, _ := getMockApp(t, 0)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+
+	// a proposal submitted after a change of the deposit procedure ends first
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	depositProcedure := keeper.GetDepositProcedure(ctx)
+	depositProcedure.MaxDepositPeriod = depositProcedure.MaxDepositPeriod / 2
+	keeper.setDepositProcedure(ctx, depositProcedure)
+	ctx = withElapsedTime(ctx, time.Duration(10)*time.Second)
+	proposal2 := keeper.NewTextProposal(ctx, "Test2", "description", ProposalTypeText)
+
+	require.Equal(t, proposal2.GetProposalID(), keeper.InactiveProposalQueuePeek(ctx).GetProposalID())
+	require.Equal(t, proposal2.GetProposalID(), keeper.InactiveProposalQueuePop(ctx).GetProposalID())
+	require.Equal(t, proposal.GetProposalID(), keeper.InactiveProposalQueuePop(ctx).GetProposalID())
+	require.Nil(t, keeper.InactiveProposalQueuePeek(ctx))
+}
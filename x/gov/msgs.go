@@ -17,6 +17,10 @@ type MsgSubmitProposal struct {
 	ProposalType   ProposalKind   //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 	Proposer       sdk.AccAddress //  Address of the proposer
 	InitialDeposit sdk.Coins      //  Initial deposit paid by sender. Must be strictly positive.
+	Recipient      sdk.AccAddress //  Recipient of a community pool spend, empty for other proposal types
+	Amount         sdk.Coins      //  Coins to send from the community pool, empty for other proposal types
+	Changes        []ParamChange  //  Parameter changes of a parameter change proposal, empty for other proposal types
+	Plan           Plan           //  Upgrade plan of a software upgrade proposal, empty for other proposal types
 }
 
 func NewMsgSubmitProposal(title string, description string, proposalType ProposalKind, proposer sdk.AccAddress, initialDeposit sdk.Coins) MsgSubmitProposal {
@@ -29,6 +33,46 @@ func NewMsgSubmitProposal(title string, description string, proposalType Proposa
 	}
 }
 
+func NewMsgSubmitCommunityPoolSpendProposal(title string, description string, proposer sdk.AccAddress,
+	initialDeposit sdk.Coins, recipient sdk.AccAddress, amount sdk.Coins) MsgSubmitProposal {
+
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   ProposalTypeCommunityPoolSpend,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+		Recipient:      recipient,
+		Amount:         amount,
+	}
+}
+
+func NewMsgSubmitParameterChangeProposal(title string, description string, proposer sdk.AccAddress,
+	initialDeposit sdk.Coins, changes []ParamChange) MsgSubmitProposal {
+
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   ProposalTypeParameterChange,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+		Changes:        changes,
+	}
+}
+
+func NewMsgSubmitSoftwareUpgradeProposal(title string, description string, proposer sdk.AccAddress,
+	initialDeposit sdk.Coins, plan Plan) MsgSubmitProposal {
+
+	return MsgSubmitProposal{
+		Title:          title,
+		Description:    description,
+		ProposalType:   ProposalTypeSoftwareUpgrade,
+		Proposer:       proposer,
+		InitialDeposit: initialDeposit,
+		Plan:           plan,
+	}
+}
+
 // Implements Msg.
 func (msg MsgSubmitProposal) Type() string { return MsgType }
 
@@ -52,6 +96,36 @@ func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
 	if !msg.InitialDeposit.IsNotNegative() {
 		return sdk.ErrInvalidCoins(msg.InitialDeposit.String())
 	}
+	if msg.ProposalType == ProposalTypeCommunityPoolSpend {
+		if len(msg.Recipient) == 0 {
+			return sdk.ErrInvalidAddress(msg.Recipient.String())
+		}
+		if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+			return sdk.ErrInvalidCoins(msg.Amount.String())
+		}
+	} else if len(msg.Recipient) != 0 || len(msg.Amount) != 0 {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	if msg.ProposalType == ProposalTypeParameterChange {
+		if len(msg.Changes) == 0 {
+			return ErrInvalidParamChange(DefaultCodespace, "Parameter change proposal has no changes")
+		}
+		for _, change := range msg.Changes {
+			if len(change.Module) == 0 || len(change.Key) == 0 || len(change.Value) == 0 {
+				return ErrInvalidParamChange(DefaultCodespace, fmt.Sprintf("Parameter change '%s' is missing its module, key or value", change))
+			}
+		}
+	} else if len(msg.Changes) != 0 {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
+	if msg.ProposalType == ProposalTypeSoftwareUpgrade {
+		err := msg.Plan.Validate()
+		if err != nil {
+			return ErrInvalidUpgradePlan(DefaultCodespace, err.Error())
+		}
+	} else if msg.Plan != (Plan{}) {
+		return ErrInvalidProposalType(DefaultCodespace, msg.ProposalType)
+	}
 	return nil
 }
 
@@ -192,3 +266,60 @@ func (msg MsgVote) GetSignBytes() []byte {
 func (msg MsgVote) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Voter}
 }
+
+//-----------------------------------------------------------
+// MsgVoteWeighted
+type MsgVoteWeighted struct {
+	ProposalID int64               //  proposalID of the proposal
+	Voter      sdk.AccAddress      //  address of the voter
+	Options    WeightedVoteOptions //  options from OptionSet chosen by the voter, with the fraction of the voting power given to each
+}
+
+func NewMsgVoteWeighted(voter sdk.AccAddress, proposalID int64, options WeightedVoteOptions) MsgVoteWeighted {
+	return MsgVoteWeighted{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Options:    options,
+	}
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) Type() string { return MsgType }
+
+// Implements Msg.
+func (msg MsgVoteWeighted) ValidateBasic() sdk.Error {
+	if len(msg.Voter.Bytes()) == 0 {
+		return sdk.ErrInvalidAddress(msg.Voter.String())
+	}
+	if msg.ProposalID < 0 {
+		return ErrUnknownProposal(DefaultCodespace, msg.ProposalID)
+	}
+	err := msg.Options.Validate()
+	if err != nil {
+		return ErrInvalidWeightedVote(DefaultCodespace, msg.Options, err)
+	}
+	return nil
+}
+
+func (msg MsgVoteWeighted) String() string {
+	return fmt.Sprintf("MsgVoteWeighted{%v - %s}", msg.ProposalID, msg.Options)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) Get(key interface{}) (value interface{}) {
+	return nil
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSignBytes() []byte {
+	b, err := msgCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// Implements Msg.
+func (msg MsgVoteWeighted) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Voter}
+}
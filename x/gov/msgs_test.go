@@ -30,8 +30,8 @@ func TestMsgSubmitProposal(t *testing.T) {
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, true},
 		{"", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos, false},
 		{"Test Proposal", "", ProposalTypeText, addrs[0], coinsPos, false},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, true},
-		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, true},
+		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeParameterChange, addrs[0], coinsPos, false},
+		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeSoftwareUpgrade, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", 0x05, addrs[0], coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, sdk.AccAddress{}, coinsPos, false},
 		{"Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsZero, true},
@@ -49,6 +49,98 @@ func TestMsgSubmitProposal(t *testing.T) {
 	}
 }
 
+// test ValidateBasic for a community pool spend MsgSubmitProposal
+func TestMsgSubmitCommunityPoolSpendProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+	tests := []struct {
+		recipient  sdk.AccAddress
+		amount     sdk.Coins
+		expectPass bool
+	}{
+		{addrs[1], coinsPos, true},
+		{addrs[1], coinsMulti, true},
+		{sdk.AccAddress{}, coinsPos, false},
+		{addrs[1], coinsZero, false},
+		{addrs[1], coinsNeg, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitCommunityPoolSpendProposal("Test Proposal", "the purpose of this proposal is to test",
+			addrs[0], coinsPos, tc.recipient, tc.amount)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// other proposal types can't carry a spend
+	msg := NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos)
+	msg.Recipient, msg.Amount = addrs[1], coinsPos
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+// test ValidateBasic for a parameter change MsgSubmitProposal
+func TestMsgSubmitParameterChangeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		changes    []ParamChange
+		expectPass bool
+	}{
+		{[]ParamChange{NewParamChange("gov", "votingprocedure", `{"voting_period":"10"}`)}, true},
+		{[]ParamChange{NewParamChange("gov", "votingprocedure", `{}`), NewParamChange("auth", "params", `{}`)}, true},
+		{nil, false},
+		{[]ParamChange{NewParamChange("", "votingprocedure", `{}`)}, false},
+		{[]ParamChange{NewParamChange("gov", "", `{}`)}, false},
+		{[]ParamChange{NewParamChange("gov", "votingprocedure", "")}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitParameterChangeProposal("Test Proposal", "the purpose of this proposal is to test",
+			addrs[0], coinsPos, tc.changes)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// other proposal types can't carry parameter changes
+	msg := NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos)
+	msg.Changes = []ParamChange{NewParamChange("gov", "votingprocedure", `{"voting_period":"10"}`)}
+	require.NotNil(t, msg.ValidateBasic())
+}
+
+// test ValidateBasic for a software upgrade MsgSubmitProposal
+func TestMsgSubmitSoftwareUpgradeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	tests := []struct {
+		plan       Plan
+		expectPass bool
+	}{
+		{NewPlan("v1", 100, "https://example.com/v1"), true},
+		{NewPlan("v1", 100, ""), true},
+		{NewPlan("", 100, ""), false},
+		{NewPlan("v1", 0, ""), false},
+		{NewPlan("v1", -1, ""), false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgSubmitSoftwareUpgradeProposal("Test Proposal", "the purpose of this proposal is to test",
+			addrs[0], coinsPos, tc.plan)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	// other proposal types can't carry an upgrade plan
+	msg := NewMsgSubmitProposal("Test Proposal", "the purpose of this proposal is to test", ProposalTypeText, addrs[0], coinsPos)
+	msg.Plan = NewPlan("v1", 100, "")
+	require.NotNil(t, msg.ValidateBasic())
+}
+
 // test ValidateBasic for MsgDeposit
 func TestMsgDeposit(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
@@ -103,3 +195,52 @@ func TestMsgVote(t *testing.T) {
 		}
 	}
 }
+
+// test ValidateBasic for MsgVoteWeighted
+func TestMsgVoteWeighted(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+	half := sdk.NewDecWithPrec(5, 1)
+	tests := []struct {
+		proposalID int64
+		voterAddr  sdk.AccAddress
+		options    WeightedVoteOptions
+		expectPass bool
+	}{
+		{0, addrs[0], NewNonSplitVoteOption(OptionYes), true},
+		{-1, addrs[0], NewNonSplitVoteOption(OptionYes), false},
+		{0, sdk.AccAddress{}, NewNonSplitVoteOption(OptionYes), false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionNo, half)}, true},
+		{0, addrs[0], WeightedVoteOptions{}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(OptionYes, half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.NewDec(2)), NewWeightedVoteOption(OptionNo, sdk.NewDec(-1))}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, half), NewWeightedVoteOption(VoteOption(0x13), half)}, false},
+		{0, addrs[0], WeightedVoteOptions{NewWeightedVoteOption(OptionYes, sdk.Dec{})}, false},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgVoteWeighted(tc.voterAddr, tc.proposalID, tc.options)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestWeightedVoteOptionsFromString(t *testing.T) {
+	options, err := WeightedVoteOptionsFromString("Yes=0.6, NoWithVeto=0.4")
+	require.Nil(t, err)
+	expected := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(6, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(4, 1)),
+	}
+	require.True(t, expected.Equals(options))
+
+	_, err = WeightedVoteOptionsFromString("Yes")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Yes=0.6,No=0.6")
+	require.NotNil(t, err)
+	_, err = WeightedVoteOptionsFromString("Maybe=1")
+	require.NotNil(t, err)
+}
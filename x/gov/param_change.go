@@ -0,0 +1,156 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+)
+
+//-----------------------------------------------------------
+// ParamChange
+
+// ParamChange changes the parameter of a module, stored at "module/key" in the
+// global param store, to a JSON value
+type ParamChange struct {
+	Module string `json:"module"`
+	Key    string `json:"key"`
+	Value  string `json:"value"`
+}
+
+func NewParamChange(module, key, value string) ParamChange {
+	return ParamChange{
+		Module: module,
+		Key:    key,
+		Value:  value,
+	}
+}
+
+// key of the parameter in the global param store
+func (pc ParamChange) StoreKey() string {
+	return fmt.Sprintf("%s/%s", pc.Module, pc.Key)
+}
+
+func (pc ParamChange) String() string {
+	return fmt.Sprintf("%s = %s", pc.StoreKey(), pc.Value)
+}
+
+//-----------------------------------------------------------
+// ChangeableParam
+
+// ParamValidator decodes the JSON value of a parameter change, returning the
+// parameter to store or an error if the value is not valid
+type ParamValidator func(cdc *wire.Codec, value string) (param interface{}, err error)
+
+// ChangeableParam is a parameter of the global param store which parameter
+// change proposals can change
+type ChangeableParam struct {
+	StoreKey string
+	Validate ParamValidator
+}
+
+func NewChangeableParam(storeKey string, validate ParamValidator) ChangeableParam {
+	return ChangeableParam{
+		StoreKey: storeKey,
+		Validate: validate,
+	}
+}
+
+// the procedures of governance, which can always be changed by proposals
+func defaultChangeableParams() []ChangeableParam {
+	return []ChangeableParam{
+		NewChangeableParam(ParamStoreKeyDepositProcedure, validateDepositProcedureChange),
+		NewChangeableParam(ParamStoreKeyVotingProcedure, validateVotingProcedureChange),
+		NewChangeableParam(ParamStoreKeyTallyingProcedure, validateTallyingProcedureChange),
+	}
+}
+
+func validateDepositProcedureChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var depositProcedure DepositProcedure
+	err := cdc.UnmarshalJSON([]byte(value), &depositProcedure)
+	if err != nil {
+		return nil, err
+	}
+	return &depositProcedure, depositProcedure.Validate()
+}
+
+func validateVotingProcedureChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var votingProcedure VotingProcedure
+	err := cdc.UnmarshalJSON([]byte(value), &votingProcedure)
+	if err != nil {
+		return nil, err
+	}
+	return &votingProcedure, votingProcedure.Validate()
+}
+
+func validateTallyingProcedureChange(cdc *wire.Codec, value string) (interface{}, error) {
+	var tallyingProcedure TallyingProcedure
+	err := cdc.UnmarshalJSON([]byte(value), &tallyingProcedure)
+	if err != nil {
+		return nil, err
+	}
+	return &tallyingProcedure, tallyingProcedure.Validate()
+}
+
+//-----------------------------------------------------------
+// Keeper
+
+// Set the parameters of other modules which parameter change proposals can
+// change, in addition to the governance procedures
+func (keeper Keeper) WithChangeableParams(params ...ChangeableParam) Keeper {
+	changeableParams := make(map[string]ParamValidator)
+	for key, validate := range keeper.changeableParams {
+		changeableParams[key] = validate
+	}
+	for _, param := range params {
+		if _, ok := changeableParams[param.StoreKey]; ok {
+			panic(fmt.Sprintf("changeable param %s already set", param.StoreKey))
+		}
+		changeableParams[param.StoreKey] = param.Validate
+	}
+	keeper.changeableParams = changeableParams
+	return keeper
+}
+
+// decode and validate the values of parameter changes, returning the
+// parameters to store
+func (keeper Keeper) validateParamChanges(changes []ParamChange) ([]interface{}, sdk.Error) {
+	params := make([]interface{}, len(changes))
+	for i, change := range changes {
+		validate, ok := keeper.changeableParams[change.StoreKey()]
+		if !ok {
+			return nil, ErrUnknownParam(keeper.codespace, change)
+		}
+		param, err := validate(keeper.cdc, change.Value)
+		if err != nil {
+			return nil, ErrInvalidParamValue(keeper.codespace, change, err)
+		}
+		params[i] = param
+	}
+	return params, nil
+}
+
+// ValidateParamChanges returns an error if any of the parameter changes is of
+// a parameter which can not be changed or has an invalid value
+func (keeper Keeper) ValidateParamChanges(changes []ParamChange) sdk.Error {
+	_, err := keeper.validateParamChanges(changes)
+	return err
+}
+
+// apply the parameter changes of a passed proposal, none of which is applied
+// if any is no longer valid
+func (keeper Keeper) applyParamChanges(ctx sdk.Context, changes []ParamChange) sdk.Error {
+	params, err := keeper.validateParamChanges(changes)
+	if err != nil {
+		return err
+	}
+	cacheCtx, writeCache := ctx.CacheContext()
+	for i, change := range changes {
+		err := keeper.ps.Set(cacheCtx, change.StoreKey(), params[i])
+		if err != nil {
+			return ErrInvalidParamValue(keeper.codespace, change, err)
+		}
+	}
+	writeCache()
+	return nil
+}
@@ -1,23 +1,69 @@
 package gov
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Procedure around Deposits for governance
 type DepositProcedure struct {
-	MinDeposit       sdk.Coins `json:"min_deposit"`        //  Minimum deposit for a proposal to enter voting period.
-	MaxDepositPeriod int64     `json:"max_deposit_period"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
+	MinDeposit       sdk.Coins     `json:"min_deposit"`        //  Minimum deposit for a proposal to enter voting period.
+	MaxDepositPeriod time.Duration `json:"max_deposit_period"` //  Maximum period for Atom holders to deposit on a proposal. Initial value: 2 months
 }
 
 // Procedure around Tallying votes in governance
 type TallyingProcedure struct {
-	Threshold         sdk.Dec `json:"threshold"`          //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
-	Veto              sdk.Dec `json:"veto"`               //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
-	GovernancePenalty sdk.Dec `json:"governance_penalty"` //  Penalty if validator does not vote
+	Quorum                  sdk.Dec `json:"quorum"`                     //  Minimum proportion of bonded voting power that must vote for the result to be valid. Initial value: 0.334
+	BurnDepositsBelowQuorum bool    `json:"burn_deposits_below_quorum"` //  Whether the deposits of proposals rejected for not reaching quorum are burned rather than refunded. Initial value: true
+	Threshold               sdk.Dec `json:"threshold"`                  //  Minimum propotion of Yes votes for proposal to pass. Initial value: 0.5
+	Veto                    sdk.Dec `json:"veto"`                       //  Minimum value of Veto votes to Total votes ratio for proposal to be vetoed. Initial value: 1/3
+	GovernancePenalty       sdk.Dec `json:"governance_penalty"`         //  Penalty if validator does not vote
 }
 
 // Procedure around Voting in governance
 type VotingProcedure struct {
-	VotingPeriod int64 `json:"voting_period"` //  Length of the voting period.
+	VotingPeriod time.Duration `json:"voting_period"` //  Length of the voting period.
+}
+
+// validate the deposit procedure
+func (dp DepositProcedure) Validate() error {
+	if !dp.MinDeposit.IsValid() || !dp.MinDeposit.IsNotNegative() {
+		return fmt.Errorf("invalid minimum deposit: %v", dp.MinDeposit)
+	}
+	if dp.MaxDepositPeriod <= 0 {
+		return fmt.Errorf("maximum deposit period must be positive, is %v", dp.MaxDepositPeriod)
+	}
+	return nil
+}
+
+// validate the tallying procedure
+func (tp TallyingProcedure) Validate() error {
+	if !validFraction(tp.Quorum) {
+		return fmt.Errorf("quorum must be between 0 and 1, is %v", tp.Quorum)
+	}
+	if !validFraction(tp.Threshold) {
+		return fmt.Errorf("threshold must be between 0 and 1, is %v", tp.Threshold)
+	}
+	if !validFraction(tp.Veto) {
+		return fmt.Errorf("veto must be between 0 and 1, is %v", tp.Veto)
+	}
+	if !validFraction(tp.GovernancePenalty) {
+		return fmt.Errorf("governance penalty must be between 0 and 1, is %v", tp.GovernancePenalty)
+	}
+	return nil
+}
+
+// validate the voting procedure
+func (vp VotingProcedure) Validate() error {
+	if vp.VotingPeriod <= 0 {
+		return fmt.Errorf("voting period must be positive, is %v", vp.VotingPeriod)
+	}
+	return nil
+}
+
+// whether the decimal is set and between 0 and 1
+func validFraction(d sdk.Dec) bool {
+	return !d.IsNil() && !d.LT(sdk.ZeroDec()) && !d.GT(sdk.OneDec())
 }
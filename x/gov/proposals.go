@@ -3,6 +3,7 @@ package gov
 import (
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -30,14 +31,20 @@ type Proposal interface {
 	GetTallyResult() TallyResult
 	SetTallyResult(TallyResult)
 
-	GetSubmitBlock() int64
-	SetSubmitBlock(int64)
+	GetSubmitTime() time.Time
+	SetSubmitTime(time.Time)
+
+	GetDepositEndTime() time.Time
+	SetDepositEndTime(time.Time)
 
 	GetTotalDeposit() sdk.Coins
 	SetTotalDeposit(sdk.Coins)
 
-	GetVotingStartBlock() int64
-	SetVotingStartBlock(int64)
+	GetVotingStartTime() time.Time
+	SetVotingStartTime(time.Time)
+
+	GetVotingEndTime() time.Time
+	SetVotingEndTime(time.Time)
 }
 
 // checks if two proposals are equal
@@ -48,9 +55,11 @@ func ProposalEqual(proposalA Proposal, proposalB Proposal) bool {
 		proposalA.GetProposalType() == proposalB.GetProposalType() &&
 		proposalA.GetStatus() == proposalB.GetStatus() &&
 		proposalA.GetTallyResult().Equals(proposalB.GetTallyResult()) &&
-		proposalA.GetSubmitBlock() == proposalB.GetSubmitBlock() &&
+		proposalA.GetSubmitTime().Equal(proposalB.GetSubmitTime()) &&
+		proposalA.GetDepositEndTime().Equal(proposalB.GetDepositEndTime()) &&
 		proposalA.GetTotalDeposit().IsEqual(proposalB.GetTotalDeposit()) &&
-		proposalA.GetVotingStartBlock() == proposalB.GetVotingStartBlock() {
+		proposalA.GetVotingStartTime().Equal(proposalB.GetVotingStartTime()) &&
+		proposalA.GetVotingEndTime().Equal(proposalB.GetVotingEndTime()) {
 		return true
 	}
 	return false
@@ -64,13 +73,15 @@ type TextProposal struct {
 	Description  string       `json:"description"`   //  Description of the proposal
 	ProposalType ProposalKind `json:"proposal_type"` //  Type of proposal. Initial set {PlainTextProposal, SoftwareUpgradeProposal}
 
-	Status      ProposalStatus `json:"proposal_status"` //  Status of the Proposal {Pending, Active, Passed, Rejected}
+	Status      ProposalStatus `json:"proposal_status"` //  Status of the Proposal {Pending, Active, Passed, Rejected, Failed}
 	TallyResult TallyResult    `json:"tally_result"`    //  Result of Tallys
 
-	SubmitBlock  int64     `json:"submit_block"`  //  Height of the block where TxGovSubmitProposal was included
-	TotalDeposit sdk.Coins `json:"total_deposit"` //  Current deposit on this proposal. Initial value is set at InitialDeposit
+	SubmitTime     time.Time `json:"submit_time"`      //  Time of the block where TxGovSubmitProposal was included
+	DepositEndTime time.Time `json:"deposit_end_time"` //  Time at which the proposal is deleted if MinDeposit is not reached
+	TotalDeposit   sdk.Coins `json:"total_deposit"`    //  Current deposit on this proposal. Initial value is set at InitialDeposit
 
-	VotingStartBlock int64 `json:"voting_start_block"` //  Height of the block where MinDeposit was reached. -1 if MinDeposit is not reached
+	VotingStartTime time.Time `json:"voting_start_time"` //  Time of the block where MinDeposit was reached. Zero if MinDeposit is not reached
+	VotingEndTime   time.Time `json:"voting_end_time"`   //  Time at which the votes are tallied. Zero if MinDeposit is not reached
 }
 
 // Implements Proposal Interface
@@ -89,18 +100,53 @@ func (tp TextProposal) GetStatus() ProposalStatus                  { return tp.S
 func (tp *TextProposal) SetStatus(status ProposalStatus)           { tp.Status = status }
 func (tp TextProposal) GetTallyResult() TallyResult                { return tp.TallyResult }
 func (tp *TextProposal) SetTallyResult(tallyResult TallyResult)    { tp.TallyResult = tallyResult }
-func (tp TextProposal) GetSubmitBlock() int64                      { return tp.SubmitBlock }
-func (tp *TextProposal) SetSubmitBlock(submitBlock int64)          { tp.SubmitBlock = submitBlock }
-func (tp TextProposal) GetTotalDeposit() sdk.Coins                 { return tp.TotalDeposit }
-func (tp *TextProposal) SetTotalDeposit(totalDeposit sdk.Coins)    { tp.TotalDeposit = totalDeposit }
-func (tp TextProposal) GetVotingStartBlock() int64                 { return tp.VotingStartBlock }
-func (tp *TextProposal) SetVotingStartBlock(votingStartBlock int64) {
-	tp.VotingStartBlock = votingStartBlock
+func (tp TextProposal) GetSubmitTime() time.Time                   { return tp.SubmitTime }
+func (tp *TextProposal) SetSubmitTime(submitTime time.Time)        { tp.SubmitTime = submitTime }
+func (tp TextProposal) GetDepositEndTime() time.Time               { return tp.DepositEndTime }
+func (tp *TextProposal) SetDepositEndTime(depositEndTime time.Time) {
+	tp.DepositEndTime = depositEndTime
+}
+func (tp TextProposal) GetTotalDeposit() sdk.Coins              { return tp.TotalDeposit }
+func (tp *TextProposal) SetTotalDeposit(totalDeposit sdk.Coins) { tp.TotalDeposit = totalDeposit }
+func (tp TextProposal) GetVotingStartTime() time.Time           { return tp.VotingStartTime }
+func (tp *TextProposal) SetVotingStartTime(votingStartTime time.Time) {
+	tp.VotingStartTime = votingStartTime
 }
+func (tp TextProposal) GetVotingEndTime() time.Time { return tp.VotingEndTime }
+func (tp *TextProposal) SetVotingEndTime(votingEndTime time.Time) {
+	tp.VotingEndTime = votingEndTime
+}
+
+//-----------------------------------------------------------
+// Community Pool Spend Proposals
+type CommunityPoolSpendProposal struct {
+	TextProposal
+	Recipient sdk.AccAddress `json:"recipient"` //  Address receiving the coins if the proposal passes
+	Amount    sdk.Coins      `json:"amount"`    //  Coins to send from the community pool
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*CommunityPoolSpendProposal)(nil)
 
 //-----------------------------------------------------------
-// ProposalQueue
-type ProposalQueue []int64
+// Parameter Change Proposals
+type ParameterChangeProposal struct {
+	TextProposal
+	Changes []ParamChange `json:"changes"` //  Parameter changes applied if the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*ParameterChangeProposal)(nil)
+
+//-----------------------------------------------------------
+// Software Upgrade Proposals
+type SoftwareUpgradeProposal struct {
+	TextProposal
+	Plan Plan `json:"plan"` //  Upgrade plan scheduled if the proposal passes
+}
+
+// Implements Proposal Interface
+var _ Proposal = (*SoftwareUpgradeProposal)(nil)
 
 //-----------------------------------------------------------
 // ProposalKind
@@ -110,21 +156,24 @@ type ProposalKind byte
 
 //nolint
 const (
-	ProposalTypeNil             ProposalKind = 0x00
-	ProposalTypeText            ProposalKind = 0x01
-	ProposalTypeParameterChange ProposalKind = 0x02
-	ProposalTypeSoftwareUpgrade ProposalKind = 0x03
+	ProposalTypeNil                ProposalKind = 0x00
+	ProposalTypeText               ProposalKind = 0x01
+	ProposalTypeParameterChange    ProposalKind = 0x02
+	ProposalTypeSoftwareUpgrade    ProposalKind = 0x03
+	ProposalTypeCommunityPoolSpend ProposalKind = 0x04
 )
 
 // String to proposalType byte.  Returns ff if invalid.
 func ProposalTypeFromString(str string) (ProposalKind, error) {
 	switch str {
-	case "Text":
+	case "Text", "text":
 		return ProposalTypeText, nil
-	case "ParameterChange":
+	case "ParameterChange", "parameter-change":
 		return ProposalTypeParameterChange, nil
-	case "SoftwareUpgrade":
+	case "SoftwareUpgrade", "software-upgrade":
 		return ProposalTypeSoftwareUpgrade, nil
+	case "CommunityPoolSpend", "community-pool-spend":
+		return ProposalTypeCommunityPoolSpend, nil
 	default:
 		return ProposalKind(0xff), errors.Errorf("'%s' is not a valid proposal type", str)
 	}
@@ -134,7 +183,8 @@ func ProposalTypeFromString(str string) (ProposalKind, error) {
 func validProposalType(pt ProposalKind) bool {
 	if pt == ProposalTypeText ||
 		pt == ProposalTypeParameterChange ||
-		pt == ProposalTypeSoftwareUpgrade {
+		pt == ProposalTypeSoftwareUpgrade ||
+		pt == ProposalTypeCommunityPoolSpend {
 		return true
 	}
 	return false
@@ -181,6 +231,8 @@ func (pt ProposalKind) String() string {
 		return "ParameterChange"
 	case ProposalTypeSoftwareUpgrade:
 		return "SoftwareUpgrade"
+	case ProposalTypeCommunityPoolSpend:
+		return "CommunityPoolSpend"
 	default:
 		return ""
 	}
@@ -209,6 +261,7 @@ const (
 	StatusVotingPeriod  ProposalStatus = 0x02
 	StatusPassed        ProposalStatus = 0x03
 	StatusRejected      ProposalStatus = 0x04
+	StatusFailed        ProposalStatus = 0x05
 )
 
 // ProposalStatusToString turns a string into a ProposalStatus
@@ -222,6 +275,8 @@ func ProposalStatusFromString(str string) (ProposalStatus, error) {
 		return StatusPassed, nil
 	case "Rejected":
 		return StatusRejected, nil
+	case "Failed":
+		return StatusFailed, nil
 	case "":
 		return StatusNil, nil
 	default:
@@ -234,7 +289,8 @@ func validProposalStatus(status ProposalStatus) bool {
 	if status == StatusDepositPeriod ||
 		status == StatusVotingPeriod ||
 		status == StatusPassed ||
-		status == StatusRejected {
+		status == StatusRejected ||
+		status == StatusFailed {
 		return true
 	}
 	return false
@@ -283,6 +339,8 @@ func (status ProposalStatus) String() string {
 		return "Passed"
 	case StatusRejected:
 		return "Rejected"
+	case StatusFailed:
+		return "Failed"
 	default:
 		return ""
 	}
@@ -305,6 +363,7 @@ type TallyResult struct {
 	Abstain    sdk.Dec `json:"abstain"`
 	No         sdk.Dec `json:"no"`
 	NoWithVeto sdk.Dec `json:"no_with_veto"`
+	Turnout    sdk.Dec `json:"turnout"` // proportion of the bonded voting power which voted
 }
 
 // checks if two proposals are equal
@@ -314,6 +373,7 @@ func EmptyTallyResult() TallyResult {
 		Abstain:    sdk.ZeroDec(),
 		No:         sdk.ZeroDec(),
 		NoWithVeto: sdk.ZeroDec(),
+		Turnout:    sdk.ZeroDec(),
 	}
 }
 
@@ -322,7 +382,8 @@ func (resultA TallyResult) Equals(resultB TallyResult) bool {
 	if resultA.Yes.Equal(resultB.Yes) &&
 		resultA.Abstain.Equal(resultB.Abstain) &&
 		resultA.No.Equal(resultB.No) &&
-		resultA.NoWithVeto.Equal(resultB.NoWithVeto) {
+		resultA.NoWithVeto.Equal(resultB.NoWithVeto) &&
+		resultA.Turnout.Equal(resultB.Turnout) {
 		return true
 	}
 	return false
@@ -25,6 +25,10 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryProposals(ctx, path[1:], req, keeper)
 		case "tally":
 			return queryTally(ctx, path[1:], req, keeper)
+		case "upgrade_plan":
+			return queryUpgradePlan(ctx, path[1:], req, keeper)
+		case "applied_upgrade":
+			return queryAppliedUpgrade(ctx, path[1:], req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown gov query endpoint")
 		}
@@ -199,7 +203,8 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 
 	if proposal.GetStatus() == StatusDepositPeriod {
 		tallyResult = EmptyTallyResult()
-	} else if proposal.GetStatus() == StatusPassed || proposal.GetStatus() == StatusRejected {
+	} else if proposal.GetStatus() == StatusPassed || proposal.GetStatus() == StatusRejected ||
+		proposal.GetStatus() == StatusFailed {
 		tallyResult = proposal.GetTallyResult()
 	} else {
 		_, tallyResult, _ = tally(ctx, keeper, proposal)
@@ -211,3 +216,29 @@ func queryTally(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Ke
 	}
 	return bz, nil
 }
+
+func queryUpgradePlan(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found {
+		return []byte{}, ErrNoUpgradePlan(DefaultCodespace)
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, plan)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryAppliedUpgrade(ctx sdk.Context, path []string, req abci.RequestQuery, keeper Keeper) (res []byte, err sdk.Error) {
+	plan, found := keeper.GetLastAppliedUpgrade(ctx)
+	if !found {
+		return []byte{}, ErrNoUpgradePlan(DefaultCodespace)
+	}
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, plan)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
@@ -100,6 +100,29 @@ func SimulateMsgVote(k gov.Keeper, sk stake.Keeper) simulation.Operation {
 	}
 }
 
+// SimulateMsgVoteWeighted
+func SimulateMsgVoteWeighted(k gov.Keeper, sk stake.Keeper) simulation.Operation {
+	return func(t *testing.T, r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, keys []crypto.PrivKey, log string, event func(string)) (action string, fOp []simulation.FutureOperation, err sdk.Error) {
+		key := simulation.RandomKey(r, keys)
+		addr := sdk.AccAddress(key.PubKey().Address())
+		proposalID, ok := randomProposalID(r, k, ctx)
+		if !ok {
+			return "no-operation", nil, nil
+		}
+		options := randomWeightedVoteOptions(r)
+		msg := gov.NewMsgVoteWeighted(addr, proposalID, options)
+		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
+		ctx, write := ctx.CacheContext()
+		result := gov.NewHandler(k)(ctx, msg)
+		if result.IsOK() {
+			write()
+		}
+		event(fmt.Sprintf("gov/MsgVoteWeighted/%v", result.IsOK()))
+		action = fmt.Sprintf("TestMsgVoteWeighted: ok %v, msg %s", result.IsOK(), msg.GetSignBytes())
+		return action, nil, nil
+	}
+}
+
 // Pick a random deposit
 func randomDeposit(r *rand.Rand) sdk.Coins {
 	// TODO Choose based on account balance and min deposit
@@ -131,3 +154,17 @@ func randomVotingOption(r *rand.Rand) gov.VoteOption {
 	}
 	panic("should not happen")
 }
+
+// Pick random weights of two distinct voting options
+func randomWeightedVoteOptions(r *rand.Rand) gov.WeightedVoteOptions {
+	first := randomVotingOption(r)
+	second := randomVotingOption(r)
+	if first == second {
+		return gov.NewNonSplitVoteOption(first)
+	}
+	weight := sdk.NewDecWithPrec(int64(r.Intn(99))+1, 2)
+	return gov.WeightedVoteOptions{
+		gov.NewWeightedVoteOption(first, weight),
+		gov.NewWeightedVoteOption(second, sdk.OneDec().Sub(weight)),
+	}
+}
@@ -30,7 +30,7 @@ func TestGovWithRandomMessages(t *testing.T) {
 	paramKey := sdk.NewKVStoreKey("params")
 	paramKeeper := params.NewKeeper(mapp.Cdc, paramKey)
 	govKey := sdk.NewKVStoreKey("gov")
-	govKeeper := gov.NewKeeper(mapp.Cdc, govKey, paramKeeper.Setter(), coinKeeper, stakeKeeper, gov.DefaultCodespace)
+	govKeeper := gov.NewKeeper(mapp.Cdc, govKey, paramKeeper.Setter(), coinKeeper, stakeKeeper, nil, gov.DefaultCodespace)
 	mapp.Router().AddRoute("gov", gov.NewHandler(govKeeper))
 	mapp.SetEndBlocker(func(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 		gov.EndBlocker(ctx, govKeeper)
@@ -59,6 +59,7 @@ func TestGovWithRandomMessages(t *testing.T) {
 			SimulateMsgSubmitProposal(govKeeper, stakeKeeper),
 			SimulateMsgDeposit(govKeeper, stakeKeeper),
 			SimulateMsgVote(govKeeper, stakeKeeper),
+			SimulateMsgVoteWeighted(govKeeper, stakeKeeper),
 		}, []simulation.RandSetup{
 			setup,
 		}, []simulation.Invariant{
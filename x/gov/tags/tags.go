@@ -12,6 +12,7 @@ var (
 	ActionProposalDropped  = []byte("proposal-dropped")
 	ActionProposalPassed   = []byte("proposal-passed")
 	ActionProposalRejected = []byte("proposal-rejected")
+	ActionProposalFailed   = []byte("proposal-failed")
 
 	Action            = sdk.TagAction
 	Proposer          = "proposer"
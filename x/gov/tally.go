@@ -6,11 +6,11 @@ import (
 
 // validatorGovInfo used for tallying
 type validatorGovInfo struct {
-	Address         sdk.AccAddress // sdk.AccAddress of the validator owner
-	Power           sdk.Dec        // Power of a Validator
-	DelegatorShares sdk.Dec        // Total outstanding delegator shares
-	Minus           sdk.Dec        // Minus of validator, used to compute validator's voting power
-	Vote            VoteOption     // Vote of the validator
+	Address         sdk.AccAddress      // sdk.AccAddress of the validator owner
+	Power           sdk.Dec             // Power of a Validator
+	DelegatorShares sdk.Dec             // Total outstanding delegator shares
+	Minus           sdk.Dec             // Minus of validator, used to compute validator's voting power
+	Vote            WeightedVoteOptions // Vote of the validator, nil if it did not vote
 }
 
 func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tallyResults TallyResult, nonVoting []sdk.AccAddress) {
@@ -21,15 +21,17 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 	results[OptionNoWithVeto] = sdk.ZeroDec()
 
 	totalVotingPower := sdk.ZeroDec()
+	totalBondedPower := sdk.ZeroDec()
 	currValidators := make(map[string]validatorGovInfo)
 
 	keeper.vs.IterateValidatorsBonded(ctx, func(index int64, validator sdk.Validator) (stop bool) {
+		totalBondedPower = totalBondedPower.Add(validator.GetPower())
 		currValidators[validator.GetOperator().String()] = validatorGovInfo{
 			Address:         validator.GetOperator(),
 			Power:           validator.GetPower(),
 			DelegatorShares: validator.GetDelegatorShares(),
 			Minus:           sdk.ZeroDec(),
-			Vote:            OptionEmpty,
+			Vote:            nil,
 		}
 		return false
 	})
@@ -44,7 +46,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 		// if validator, just record it in the map
 		// if delegator tally voting power
 		if val, ok := currValidators[vote.Voter.String()]; ok {
-			val.Vote = vote.Option
+			val.Vote = vote.Options
 			currValidators[vote.Voter.String()] = val
 		} else {
 
@@ -56,7 +58,9 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 					delegatorShare := delegation.GetBondShares().Quo(val.DelegatorShares)
 					votingPower := val.Power.Mul(delegatorShare)
 
-					results[vote.Option] = results[vote.Option].Add(votingPower)
+					for _, option := range vote.Options {
+						results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+					}
 					totalVotingPower = totalVotingPower.Add(votingPower)
 				}
 				return false
@@ -69,7 +73,7 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 	// Iterate over the validators again to tally their voting power and see who didn't vote
 	nonVoting = []sdk.AccAddress{}
 	for _, val := range currValidators {
-		if val.Vote == OptionEmpty {
+		if val.Vote == nil {
 			nonVoting = append(nonVoting, val.Address)
 			continue
 		}
@@ -77,19 +81,31 @@ func tally(ctx sdk.Context, keeper Keeper, proposal Proposal) (passes bool, tall
 		percentAfterMinus := sharesAfterMinus.Quo(val.DelegatorShares)
 		votingPower := val.Power.Mul(percentAfterMinus)
 
-		results[val.Vote] = results[val.Vote].Add(votingPower)
+		for _, option := range val.Vote {
+			results[option.Option] = results[option.Option].Add(votingPower.Mul(option.Weight))
+		}
 		totalVotingPower = totalVotingPower.Add(votingPower)
 	}
 
 	tallyingProcedure := keeper.GetTallyingProcedure(ctx)
 
+	turnout := sdk.ZeroDec()
+	if totalBondedPower.GT(sdk.ZeroDec()) {
+		turnout = totalVotingPower.Quo(totalBondedPower)
+	}
+
 	tallyResults = TallyResult{
 		Yes:        results[OptionYes],
 		Abstain:    results[OptionAbstain],
 		No:         results[OptionNo],
 		NoWithVeto: results[OptionNoWithVeto],
+		Turnout:    turnout,
 	}
 
+	// If less than the quorum of the bonded voting power votes, proposal fails
+	if turnout.LT(tallyingProcedure.Quorum) {
+		return false, tallyResults, nonVoting
+	}
 	// If no one votes, proposal fails
 	if totalVotingPower.Sub(results[OptionAbstain]).Equal(sdk.ZeroDec()) {
 		return false, tallyResults, nonVoting
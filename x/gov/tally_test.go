@@ -15,13 +15,15 @@ import (
 
 var (
 	pubkeys = []crypto.PubKey{ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey(), ed25519.GenPrivKey().PubKey()}
+
+	testCommissionMsg = stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 )
 
 func createValidators(t *testing.T, stakeHandler sdk.Handler, ctx sdk.Context, addrs []sdk.AccAddress, coinAmt []int64) {
 	require.True(t, len(addrs) <= len(pubkeys), "Not enough pubkeys specified at top of file.")
 	dummyDescription := stake.NewDescription("T", "E", "S", "T")
 	for i := 0; i < len(addrs); i++ {
-		valCreateMsg := stake.NewMsgCreateValidator(addrs[i], pubkeys[i], sdk.NewInt64Coin("steak", coinAmt[i]), dummyDescription)
+		valCreateMsg := stake.NewMsgCreateValidator(addrs[i], pubkeys[i], sdk.NewInt64Coin("steak", coinAmt[i]), dummyDescription, testCommissionMsg)
 		res := stakeHandler(ctx, valCreateMsg)
 		require.True(t, res.IsOK())
 	}
@@ -223,6 +225,56 @@ func TestTallyOnlyValidatorsNonVoter(t *testing.T) {
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
 
+func TestTallyOnlyValidatorsQuorumNotReached(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:3], []int64{2, 5, 13})
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	err := keeper.AddVote(ctx, proposalID, addrs[0], OptionYes)
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[1], OptionYes)
+	require.Nil(t, err)
+
+	passes, tallyResults, nonVoting := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+
+	// all votes are yes but only 7 of the 20 bonded power voted
+	require.False(t, passes)
+	require.Equal(t, 1, len(nonVoting))
+	require.True(t, tallyResults.Turnout.Equal(sdk.NewDecWithPrec(35, 2)))
+}
+
+func TestTallyOnlyValidatorsQuorumReached(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:3], []int64{2, 5, 13})
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	err := keeper.AddVote(ctx, proposalID, addrs[0], OptionNo)
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[2], OptionYes)
+	require.Nil(t, err)
+
+	passes, tallyResults, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+
+	require.True(t, passes)
+	require.True(t, tallyResults.Turnout.Equal(sdk.NewDecWithPrec(75, 2)))
+}
+
 func TestTallyDelgatorOverride(t *testing.T) {
 	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
 	mapp.BeginBlock(abci.RequestBeginBlock{})
@@ -324,11 +376,11 @@ func TestTallyDelgatorMultipleInherit(t *testing.T) {
 	stakeHandler := stake.NewHandler(sk)
 
 	dummyDescription := stake.NewDescription("T", "E", "S", "T")
-	val1CreateMsg := stake.NewMsgCreateValidator(addrs[0], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 25), dummyDescription)
+	val1CreateMsg := stake.NewMsgCreateValidator(addrs[0], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 25), dummyDescription, testCommissionMsg)
 	stakeHandler(ctx, val1CreateMsg)
-	val2CreateMsg := stake.NewMsgCreateValidator(addrs[1], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 6), dummyDescription)
+	val2CreateMsg := stake.NewMsgCreateValidator(addrs[1], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 6), dummyDescription, testCommissionMsg)
 	stakeHandler(ctx, val2CreateMsg)
-	val3CreateMsg := stake.NewMsgCreateValidator(addrs[2], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 7), dummyDescription)
+	val3CreateMsg := stake.NewMsgCreateValidator(addrs[2], ed25519.GenPrivKey().PubKey(), sdk.NewInt64Coin("steak", 7), dummyDescription, testCommissionMsg)
 	stakeHandler(ctx, val3CreateMsg)
 
 	delegator1Msg := stake.NewMsgDelegate(addrs[3], addrs[2], sdk.NewInt64Coin("steak", 10))
@@ -387,3 +439,71 @@ func TestTallyJailedValidator(t *testing.T) {
 	require.True(t, passes)
 	require.False(t, tallyResults.Equals(EmptyTallyResult()))
 }
+
+func TestTallyOnlyValidatorsWeightedVote(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:2], []int64{5, 5})
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionYes, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+	}
+	err := keeper.AddWeightedVote(ctx, proposalID, addrs[0], options)
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[1], OptionYes)
+	require.Nil(t, err)
+
+	passes, tallyResults, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+
+	require.True(t, passes)
+	require.True(t, tallyResults.Yes.Equal(sdk.NewDecWithPrec(75, 1)))
+	require.True(t, tallyResults.No.Equal(sdk.NewDecWithPrec(25, 1)))
+	require.True(t, tallyResults.Turnout.Equal(sdk.OneDec()))
+}
+
+func TestTallyDelgatorWeightedOverride(t *testing.T) {
+	mapp, keeper, sk, addrs, _, _ := getMockApp(t, 10)
+	mapp.BeginBlock(abci.RequestBeginBlock{})
+	ctx := mapp.BaseApp.NewContext(false, abci.Header{})
+	stakeHandler := stake.NewHandler(sk)
+
+	createValidators(t, stakeHandler, ctx, addrs[:3], []int64{5, 6, 7})
+
+	delegator1Msg := stake.NewMsgDelegate(addrs[3], addrs[2], sdk.NewInt64Coin("steak", 30))
+	stakeHandler(ctx, delegator1Msg)
+
+	proposal := keeper.NewTextProposal(ctx, "Test", "description", ProposalTypeText)
+	proposalID := proposal.GetProposalID()
+	proposal.SetStatus(StatusVotingPeriod)
+	keeper.SetProposal(ctx, proposal)
+
+	err := keeper.AddVote(ctx, proposalID, addrs[0], OptionYes)
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[1], OptionYes)
+	require.Nil(t, err)
+	err = keeper.AddVote(ctx, proposalID, addrs[2], OptionYes)
+	require.Nil(t, err)
+	options := WeightedVoteOptions{
+		NewWeightedVoteOption(OptionNo, sdk.NewDecWithPrec(5, 1)),
+		NewWeightedVoteOption(OptionNoWithVeto, sdk.NewDecWithPrec(5, 1)),
+	}
+	err = keeper.AddWeightedVote(ctx, proposalID, addrs[3], options)
+	require.Nil(t, err)
+
+	passes, tallyResults, _ := tally(ctx, keeper, keeper.GetProposal(ctx, proposalID))
+
+	// the delegator splits its 30 voting power, which is not vetoed or passed
+	require.False(t, passes)
+	require.True(t, tallyResults.No.Equal(tallyResults.NoWithVeto))
+	require.True(t, tallyResults.No.GT(sdk.NewDec(14)))
+	require.True(t, tallyResults.Yes.LT(sdk.NewDec(19)))
+}
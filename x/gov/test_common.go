@@ -14,7 +14,9 @@ import (
 	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/mock"
 	"github.com/cosmos/cosmos-sdk/x/stake"
 )
@@ -24,22 +26,25 @@ func getMockApp(t *testing.T, numGenAccs int) (*mock.App, Keeper, stake.Keeper,
 	mapp := mock.NewApp()
 
 	stake.RegisterWire(mapp.Cdc)
+	distribution.RegisterWire(mapp.Cdc)
 	RegisterWire(mapp.Cdc)
 
 	keyGlobalParams := sdk.NewKVStoreKey("params")
 	keyStake := sdk.NewKVStoreKey("stake")
+	keyDistr := sdk.NewKVStoreKey("distr")
 	keyGov := sdk.NewKVStoreKey("gov")
 
 	pk := params.NewKeeper(mapp.Cdc, keyGlobalParams)
-	ck := bank.NewKeeper(mapp.AccountMapper)
+	ck := bank.NewKeeper(mapp.AccountMapper).WithModuleAccounts(auth.FeeCollectorName, distribution.ModuleName, ModuleName)
 	sk := stake.NewKeeper(mapp.Cdc, keyStake, ck, mapp.RegisterCodespace(stake.DefaultCodespace))
-	keeper := NewKeeper(mapp.Cdc, keyGov, pk.Setter(), ck, sk, DefaultCodespace)
+	dk := distribution.NewKeeper(mapp.Cdc, keyDistr, ck, sk, mapp.FeeCollectionKeeper, mapp.RegisterCodespace(distribution.DefaultCodespace))
+	keeper := NewKeeper(mapp.Cdc, keyGov, pk.Setter(), ck, sk, dk, DefaultCodespace)
 	mapp.Router().AddRoute("gov", NewHandler(keeper))
 
 	mapp.SetEndBlocker(getEndBlocker(keeper))
-	mapp.SetInitChainer(getInitChainer(mapp, keeper, sk))
+	mapp.SetInitChainer(getInitChainer(mapp, keeper, sk, dk))
 
-	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyStake, keyGov, keyGlobalParams}))
+	require.NoError(t, mapp.CompleteSetup([]*sdk.KVStoreKey{keyStake, keyDistr, keyGov, keyGlobalParams}))
 
 	genAccs, addrs, pubKeys, privKeys := mock.CreateGenAccounts(numGenAccs, sdk.Coins{sdk.NewInt64Coin("steak", 42)})
 
@@ -59,7 +64,7 @@ func getEndBlocker(keeper Keeper) sdk.EndBlocker {
 }
 
 // gov and stake initchainer
-func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper) sdk.InitChainer {
+func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper, distrKeeper distribution.Keeper) sdk.InitChainer {
 	return func(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 		mapp.InitChainer(ctx, req)
 
@@ -70,6 +75,7 @@ func getInitChainer(mapp *mock.App, keeper Keeper, stakeKeeper stake.Keeper) sdk
 		if err != nil {
 			panic(err)
 		}
+		distribution.InitGenesis(ctx, distrKeeper, distribution.DefaultGenesisState())
 		InitGenesis(ctx, keeper, DefaultGenesisState())
 		return abci.ResponseInitChain{
 			Validators: validators,
@@ -0,0 +1,130 @@
+package gov
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+//-----------------------------------------------------------
+// Plan
+
+// Plan of a software upgrade, which halts the chain at the planned height
+// until a binary which has registered a handler for the plan name is started
+type Plan struct {
+	Name   string `json:"name"`   //  Name of the upgrade, used to find its handler
+	Height int64  `json:"height"` //  Height of the first block processed by the upgraded binary
+	Info   string `json:"info"`   //  Information about the upgrade, like where to download the binary
+}
+
+func NewPlan(name string, height int64, info string) Plan {
+	return Plan{
+		Name:   name,
+		Height: height,
+		Info:   info,
+	}
+}
+
+// validate the plan
+func (p Plan) Validate() error {
+	if len(p.Name) == 0 {
+		return fmt.Errorf("upgrade plan name cannot be empty")
+	}
+	if p.Height <= 0 {
+		return fmt.Errorf("upgrade plan height must be positive, is %d", p.Height)
+	}
+	return nil
+}
+
+func (p Plan) String() string {
+	return fmt.Sprintf("Upgrade plan %s at height %d: %s", p.Name, p.Height, p.Info)
+}
+
+// UpgradeHandler migrates the state of the chain to the upgraded binary when
+// it starts at the height of the plan
+type UpgradeHandler func(ctx sdk.Context, plan Plan)
+
+//-----------------------------------------------------------
+// Keeper
+
+// Register the handler migrating the state for the upgrade plan with the name
+func (keeper Keeper) WithUpgradeHandler(name string, handler UpgradeHandler) Keeper {
+	upgradeHandlers := make(map[string]UpgradeHandler)
+	for name, handler := range keeper.upgradeHandlers {
+		upgradeHandlers[name] = handler
+	}
+	if _, ok := upgradeHandlers[name]; ok {
+		panic(fmt.Sprintf("upgrade handler %s already set", name))
+	}
+	upgradeHandlers[name] = handler
+	keeper.upgradeHandlers = upgradeHandlers
+	return keeper
+}
+
+// Returns the upgrade plan scheduled by a passed software upgrade proposal
+func (keeper Keeper) GetUpgradePlan(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyUpgradePlan)
+	if bz == nil {
+		return plan, false
+	}
+	keeper.cdc.MustUnmarshalBinary(bz, &plan)
+	return plan, true
+}
+
+// Schedules the upgrade plan of a passed software upgrade proposal, replacing
+// any plan scheduled before
+func (keeper Keeper) ScheduleUpgrade(ctx sdk.Context, plan Plan) sdk.Error {
+	err := plan.Validate()
+	if err != nil {
+		return ErrInvalidUpgradePlan(keeper.codespace, err.Error())
+	}
+	if plan.Height <= ctx.BlockHeight() {
+		return ErrInvalidUpgradePlan(keeper.codespace,
+			fmt.Sprintf("Upgrade plan height %d has already been reached", plan.Height))
+	}
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyUpgradePlan, keeper.cdc.MustMarshalBinary(plan))
+	return nil
+}
+
+func (keeper Keeper) clearUpgradePlan(ctx sdk.Context) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(KeyUpgradePlan)
+}
+
+// Returns the last upgrade plan applied by a registered handler
+func (keeper Keeper) GetLastAppliedUpgrade(ctx sdk.Context) (plan Plan, found bool) {
+	store := ctx.KVStore(keeper.storeKey)
+	bz := store.Get(KeyLastAppliedUpgrade)
+	if bz == nil {
+		return plan, false
+	}
+	keeper.cdc.MustUnmarshalBinary(bz, &plan)
+	return plan, true
+}
+
+func (keeper Keeper) setLastAppliedUpgrade(ctx sdk.Context, plan Plan) {
+	store := ctx.KVStore(keeper.storeKey)
+	store.Set(KeyLastAppliedUpgrade, keeper.cdc.MustMarshalBinary(plan))
+}
+
+// Called every block, halts the chain at the height of the upgrade plan unless
+// the running binary has registered a handler for it, which is then run
+func BeginBlocker(ctx sdk.Context, keeper Keeper) {
+	plan, found := keeper.GetUpgradePlan(ctx)
+	if !found || ctx.BlockHeight() < plan.Height {
+		return
+	}
+
+	handler, ok := keeper.upgradeHandlers[plan.Name]
+	if !ok {
+		panic(fmt.Sprintf("UPGRADE \"%s\" NEEDED at height %d: %s", plan.Name, plan.Height, plan.Info))
+	}
+
+	handler(ctx, plan)
+	keeper.clearUpgradePlan(ctx)
+	keeper.setLastAppliedUpgrade(ctx, plan)
+
+	ctx.Logger().With("module", "x/gov").Info(fmt.Sprintf("Applied upgrade \"%s\" at height %d", plan.Name, ctx.BlockHeight()))
+}
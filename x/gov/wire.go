@@ -10,9 +10,13 @@ func RegisterWire(cdc *wire.Codec) {
 	cdc.RegisterConcrete(MsgSubmitProposal{}, "cosmos-sdk/MsgSubmitProposal", nil)
 	cdc.RegisterConcrete(MsgDeposit{}, "cosmos-sdk/MsgDeposit", nil)
 	cdc.RegisterConcrete(MsgVote{}, "cosmos-sdk/MsgVote", nil)
+	cdc.RegisterConcrete(MsgVoteWeighted{}, "cosmos-sdk/MsgVoteWeighted", nil)
 
 	cdc.RegisterInterface((*Proposal)(nil), nil)
 	cdc.RegisterConcrete(&TextProposal{}, "gov/TextProposal", nil)
+	cdc.RegisterConcrete(&CommunityPoolSpendProposal{}, "gov/CommunityPoolSpendProposal", nil)
+	cdc.RegisterConcrete(&ParameterChangeProposal{}, "gov/ParameterChangeProposal", nil)
+	cdc.RegisterConcrete(&SoftwareUpgradeProposal{}, "gov/SoftwareUpgradeProposal", nil)
 }
 
 var msgCdc = wire.NewCodec()
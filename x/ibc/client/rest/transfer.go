@@ -29,6 +29,7 @@ type transferBody struct {
 	AccountNumber    int64     `json:"account_number"`
 	Sequence         int64     `json:"sequence"`
 	Gas              int64     `json:"gas"`
+	TimeoutHeight    int64     `json:"timeout_height"`
 }
 
 // TransferRequestHandler - http request handler to transfer coins to a address
@@ -74,6 +75,7 @@ func TransferRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.C
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
 			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
 		}
 
 		if m.Gas == 0 {
@@ -4,9 +4,13 @@ import (
 	"reflect"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 )
 
+// name of the module account escrowing the coins sent over IBC
+const ModuleName = "ibc"
+
 func NewHandler(ibcm Mapper, ck bank.Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
@@ -21,11 +25,18 @@ func NewHandler(ibcm Mapper, ck bank.Keeper) sdk.Handler {
 	}
 }
 
-// IBCTransferMsg deducts coins from the account and creates an egress IBC packet.
+// IBCTransferMsg escrows coins from the account in the ibc module account and
+// creates an egress IBC packet.
 func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCTransferMsg) sdk.Result {
 	packet := msg.IBCPacket
 
-	_, _, err := ck.SubtractCoins(ctx, packet.SrcAddr, packet.Coins)
+	err := ck.CheckSendEnabled(ctx, packet.Coins)
+	if err != nil {
+		return err.Result()
+	}
+
+	escrowAcc := ck.GetModuleAccount(ctx, ModuleName, auth.Minter)
+	_, err = ck.SendCoins(ctx, packet.SrcAddr, escrowAcc.GetAddress(), packet.Coins)
 	if err != nil {
 		return err.Result()
 	}
@@ -38,7 +49,9 @@ func handleIBCTransferMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCT
 	return sdk.Result{}
 }
 
-// IBCReceiveMsg adds coins to the destination address and creates an ingress IBC packet.
+// IBCReceiveMsg sends coins from the ibc module account to the destination
+// address and creates an ingress IBC packet. The coins which are not escrowed
+// by the ibc module account are minted.
 func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCReceiveMsg) sdk.Result {
 	packet := msg.IBCPacket
 
@@ -47,7 +60,16 @@ func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCRe
 		return ErrInvalidSequence(ibcm.codespace).Result()
 	}
 
-	_, _, err := ck.AddCoins(ctx, packet.DestAddr, packet.Coins)
+	escrowAcc := ck.GetModuleAccount(ctx, ModuleName, auth.Minter)
+	mintCoins := unescrowedCoins(escrowAcc.GetCoins(), packet.Coins)
+	if !mintCoins.IsZero() {
+		_, err := ck.MintCoins(ctx, ModuleName, mintCoins)
+		if err != nil {
+			return err.Result()
+		}
+	}
+
+	_, err := ck.SendCoins(ctx, escrowAcc.GetAddress(), packet.DestAddr, packet.Coins)
 	if err != nil {
 		return err.Result()
 	}
@@ -56,3 +78,15 @@ func handleIBCReceiveMsg(ctx sdk.Context, ibcm Mapper, ck bank.Keeper, msg IBCRe
 
 	return sdk.Result{}
 }
+
+// the part of the coins exceeding the escrowed coins
+func unescrowedCoins(escrowed, coins sdk.Coins) sdk.Coins {
+	var unescrowed sdk.Coins
+	for _, coin := range coins {
+		held := escrowed.AmountOf(coin.Denom)
+		if coin.Amount.GT(held) {
+			unescrowed = append(unescrowed, sdk.NewCoin(coin.Denom, coin.Amount.Sub(held)))
+		}
+	}
+	return unescrowed
+}
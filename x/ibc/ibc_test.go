@@ -15,6 +15,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/wire"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 )
 
 // AccountMapper(/Keeper) and IBCMapper should use different StoreKey later
@@ -51,6 +52,7 @@ func makeCodec() *wire.Codec {
 	// Register AppAccount
 	cdc.RegisterInterface((*auth.Account)(nil), nil)
 	cdc.RegisterConcrete(&auth.BaseAccount{}, "test/ibc/Account", nil)
+	cdc.RegisterConcrete(&auth.ModuleAccount{}, "test/ibc/ModuleAccount", nil)
 	wire.RegisterCrypto(cdc)
 
 	cdc.Seal()
@@ -107,6 +109,10 @@ func TestIBC(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, zero, coins)
 
+	// the coins are escrowed by the ibc module account
+	escrowAddr := auth.NewModuleAddress(ModuleName)
+	require.Equal(t, mycoins, ck.GetCoins(ctx, escrowAddr))
+
 	egl = ibcm.getEgressLength(store, chainid)
 	require.Equal(t, egl, int64(1))
 
@@ -124,6 +130,7 @@ func TestIBC(t *testing.T) {
 	coins, err = getCoins(ck, ctx, dest)
 	require.Nil(t, err)
 	require.Equal(t, mycoins, coins)
+	require.True(t, ck.GetCoins(ctx, escrowAddr).IsZero())
 
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, int64(1))
@@ -133,4 +140,52 @@ func TestIBC(t *testing.T) {
 
 	igs = ibcm.GetIngressSequence(ctx, chainid)
 	require.Equal(t, igs, int64(1))
+
+	// coins which are not escrowed are minted
+	msg = IBCReceiveMsg{
+		IBCPacket: packet,
+		Relayer:   src,
+		Sequence:  1,
+	}
+	res = h(ctx, msg)
+	require.True(t, res.IsOK())
+
+	coins, err = getCoins(ck, ctx, dest)
+	require.Nil(t, err)
+	require.Equal(t, mycoins.Plus(mycoins), coins)
+	require.True(t, ck.GetCoins(ctx, escrowAddr).IsZero())
+}
+
+func TestIBCTransferSendDisabled(t *testing.T) {
+	cdc := makeCodec()
+
+	key := sdk.NewKVStoreKey("ibc")
+	ctx := defaultContext(key)
+
+	am := auth.NewAccountMapper(cdc, key, auth.ProtoBaseAccount)
+	pk := bank.NewParamsKeeper(cdc, params.NewKeeper(cdc, key).Setter())
+	ck := bank.NewKeeper(am).WithParamsKeeper(pk)
+	h := NewHandler(NewMapper(cdc, key, DefaultCodespace), ck)
+
+	src := newAddress()
+	mycoins := sdk.Coins{sdk.NewInt64Coin("mycoin", 10)}
+	_, _, err := ck.AddCoins(ctx, src, mycoins)
+	require.Nil(t, err)
+
+	p := bank.DefaultParams()
+	p.SendEnabled = []bank.SendEnabled{bank.NewSendEnabled("mycoin", false)}
+	pk.SetParams(ctx, p)
+
+	msg := IBCTransferMsg{
+		IBCPacket: IBCPacket{
+			SrcAddr:   src,
+			DestAddr:  newAddress(),
+			Coins:     mycoins,
+			SrcChain:  "ibcchain",
+			DestChain: "ibcchain",
+		},
+	}
+	res := h(ctx, msg)
+	require.Equal(t, sdk.ToABCICode(bank.DefaultCodespace, bank.CodeSendDisabled), res.Code)
+	require.Equal(t, mycoins, ck.GetCoins(ctx, src))
 }
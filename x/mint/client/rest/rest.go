@@ -0,0 +1,41 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/wire"
+
+	"github.com/gorilla/mux"
+)
+
+// RegisterRoutes registers minting-related REST handlers to a router
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *wire.Codec) {
+	r.HandleFunc(
+		"/minting/inflation",
+		queryHandlerFn(cliCtx, "mint", "inflation"),
+	).Methods("GET")
+	r.HandleFunc(
+		"/minting/annual-provisions",
+		queryHandlerFn(cliCtx, "mint", "annual_provisions"),
+	).Methods("GET")
+	r.HandleFunc(
+		"/minting/parameters",
+		queryHandlerFn(cliCtx, "mint", "parameters"),
+	).Methods("GET")
+}
+
+// http request handler to query a value of the minter without parameters
+func queryHandlerFn(cliCtx context.CLIContext, queryRoute, path string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, path), nil)
+		if err != nil {
+			utils.WriteErrorResponse(&w, http.StatusInternalServerError, fmt.Sprintf("couldn't query %s. Error: %s", path, err.Error()))
+			return
+		}
+
+		w.Write(res)
+	}
+}
@@ -0,0 +1,52 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GenesisState - all mint state that must be provided at genesis
+type GenesisState struct {
+	Minter Minter `json:"minter"`
+	Params Params `json:"params"`
+}
+
+func NewGenesisState(minter Minter, params Params) GenesisState {
+	return GenesisState{
+		Minter: minter,
+		Params: params,
+	}
+}
+
+// get raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Minter: InitialMinter(),
+		Params: DefaultParams(),
+	}
+}
+
+// InitGenesis sets the minter and parameters for genesis
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	err := ValidateGenesis(data)
+	if err != nil {
+		panic(err)
+	}
+	keeper.SetMinter(ctx, data.Minter)
+	keeper.SetParams(ctx, data.Params)
+}
+
+// WriteGenesis returns a GenesisState for a given context and keeper.
+func WriteGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	minter := keeper.GetMinter(ctx)
+	params := keeper.GetParams(ctx)
+	return NewGenesisState(minter, params)
+}
+
+// ValidateGenesis validates the provided mint genesis state
+func ValidateGenesis(data GenesisState) error {
+	err := data.Params.Validate()
+	if err != nil {
+		return err
+	}
+	return data.Minter.Validate()
+}
@@ -0,0 +1,71 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// keeper of the mint store
+type Keeper struct {
+	storeKey            sdk.StoreKey
+	cdc                 *wire.Codec
+	stakeKeeper         stake.Keeper
+	bankKeeper          bank.Keeper
+	feeCollectionKeeper auth.FeeCollectionKeeper
+}
+
+func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, sk stake.Keeper,
+	ck bank.Keeper, fck auth.FeeCollectionKeeper) Keeper {
+
+	keeper := Keeper{
+		storeKey:            key,
+		cdc:                 cdc,
+		stakeKeeper:         sk,
+		bankKeeper:          ck,
+		feeCollectionKeeper: fck,
+	}
+	return keeper
+}
+
+//______________________________________________________________________
+
+// get the minter
+func (k Keeper) GetMinter(ctx sdk.Context) (minter Minter) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(MinterKey)
+	if b == nil {
+		panic("stored minter should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(b, &minter)
+	return
+}
+
+// set the minter
+func (k Keeper) SetMinter(ctx sdk.Context, minter Minter) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(minter)
+	store.Set(MinterKey, b)
+}
+
+//______________________________________________________________________
+
+// get the mint parameters
+func (k Keeper) GetParams(ctx sdk.Context) (params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(ParamsKey)
+	if b == nil {
+		panic("stored mint params should not have been nil")
+	}
+	k.cdc.MustUnmarshalBinary(b, &params)
+	return
+}
+
+// set the mint parameters
+func (k Keeper) SetParams(ctx sdk.Context, params Params) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(params)
+	store.Set(ParamsKey, b)
+}
@@ -0,0 +1,8 @@
+package mint
+
+//nolint
+var (
+	// Keys for the mint store
+	MinterKey = []byte{0x00} // key for the minter
+	ParamsKey = []byte{0x01} // key for the mint parameters
+)
@@ -0,0 +1,71 @@
+package mint
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+// Minter represents the minting state
+type Minter struct {
+	Inflation        sdk.Dec `json:"inflation"`         // current annual inflation rate
+	AnnualProvisions sdk.Dec `json:"annual_provisions"` // current annual expected provisions
+}
+
+// initial minter for a new chain
+func InitialMinter() Minter {
+	return Minter{
+		Inflation:        sdk.NewDecWithPrec(7, 2),
+		AnnualProvisions: sdk.ZeroDec(),
+	}
+}
+
+// validate the minter
+func (m Minter) Validate() error {
+	if m.Inflation.IsNil() || m.Inflation.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("mint inflation should be non-negative, is %v", m.Inflation)
+	}
+	if m.AnnualProvisions.IsNil() || m.AnnualProvisions.LT(sdk.ZeroDec()) {
+		return fmt.Errorf("mint annual provisions should be non-negative, is %v", m.AnnualProvisions)
+	}
+	return nil
+}
+
+// get the next inflation rate for the block
+func (m Minter) NextInflationRate(params stake.Params, bondedRatio sdk.Dec, blocksPerYear int64) (inflation sdk.Dec) {
+
+	// The target annual inflation rate is recalculated for each block. The
+	// inflation is also subject to a rate change (positive or negative) depending on
+	// the distance from the desired ratio (67%). The maximum rate change possible is
+	// defined to be 13% per year, however the annual inflation is capped as between
+	// 7% and 20%.
+
+	// (1 - bondedRatio/GoalBonded) * InflationRateChange
+	inflationRateChangePerYear := sdk.OneDec().
+		Sub(bondedRatio.Quo(params.GoalBonded)).
+		Mul(params.InflationRateChange)
+	inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(blocksPerYear))
+
+	// adjust the new annual inflation for this next block
+	inflation = m.Inflation.Add(inflationRateChange)
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
+}
+
+// get the annual provisions for the current inflation rate and total supply
+func (m Minter) NextAnnualProvisions(totalSupply sdk.Dec) sdk.Dec {
+	return m.Inflation.Mul(totalSupply)
+}
+
+// get the provisions to mint for a block, truncated to whole coins
+func (m Minter) BlockProvision(denom string, blocksPerYear int64) sdk.Coin {
+	provisionAmt := m.AnnualProvisions.Quo(sdk.NewDec(blocksPerYear))
+	return sdk.NewCoin(denom, provisionAmt.TruncateInt())
+}
@@ -0,0 +1,80 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func TestNextInflation(t *testing.T) {
+	minter := InitialMinter()
+	params := stake.DefaultParams()
+	blocksPerYr := sdk.NewDec(DefaultParams().BlocksPerYear)
+
+	// Governing Mechanism:
+	//    inflationRateChangePerYear = (1- BondedRatio/ GoalBonded) * MaxInflationRateChange
+
+	tests := []struct {
+		name                                      string
+		bondedRatio, setInflation, expectedChange sdk.Dec
+	}{
+		// with 0% bonded atom supply the inflation should increase by InflationRateChange
+		{"test 1", sdk.ZeroDec(), sdk.NewDecWithPrec(7, 2), params.InflationRateChange.Quo(blocksPerYr)},
+
+		// 100% bonded, starting at 20% inflation and being reduced
+		// (1 - (1/0.67))*(0.13/blocksPerYr)
+		{"test 2", sdk.OneDec(), sdk.NewDecWithPrec(20, 2),
+			sdk.OneDec().Sub(sdk.OneDec().Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(blocksPerYr)},
+
+		// 50% bonded, starting at 10% inflation and being increased
+		{"test 3", sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(10, 2),
+			sdk.OneDec().Sub(sdk.NewDecWithPrec(5, 1).Quo(params.GoalBonded)).Mul(params.InflationRateChange).Quo(blocksPerYr)},
+
+		// test 7% minimum stop (testing with 100% bonded)
+		{"test 4", sdk.OneDec(), sdk.NewDecWithPrec(7, 2), sdk.ZeroDec()},
+		{"test 5", sdk.OneDec(), sdk.NewDecWithPrec(700000001, 10), sdk.NewDecWithPrec(-1, 10)},
+
+		// test 20% maximum stop (testing with 0% bonded)
+		{"test 6", sdk.ZeroDec(), sdk.NewDecWithPrec(20, 2), sdk.ZeroDec()},
+		{"test 7", sdk.ZeroDec(), sdk.NewDecWithPrec(1999999999, 10), sdk.NewDecWithPrec(1, 10)},
+
+		// perfect balance shouldn't change inflation
+		{"test 8", sdk.NewDecWithPrec(67, 2), sdk.NewDecWithPrec(15, 2), sdk.ZeroDec()},
+	}
+	for _, tc := range tests {
+		minter.Inflation = tc.setInflation
+
+		inflation := minter.NextInflationRate(params, tc.bondedRatio, DefaultParams().BlocksPerYear)
+		diffInflation := inflation.Sub(tc.setInflation)
+
+		require.True(t, diffInflation.Equal(tc.expectedChange),
+			"Name: %v\nDiff:  %v\nExpected: %v\n", tc.name, diffInflation, tc.expectedChange)
+	}
+}
+
+func TestBlockProvision(t *testing.T) {
+	minter := InitialMinter()
+	blocksPerYr := int64(5)
+
+	tests := []struct {
+		annualProvisions int64
+		expProvisions    int64
+	}{
+		{0, 0},
+		{4, 0},
+		{5, 1},
+		{12, 2},
+		{24, 4},
+	}
+	for i, tc := range tests {
+		minter.AnnualProvisions = sdk.NewDec(tc.annualProvisions)
+		provisions := minter.BlockProvision("steak", blocksPerYr)
+
+		expProvisions := sdk.NewInt64Coin("steak", tc.expProvisions)
+		require.True(t, expProvisions.IsEqual(provisions),
+			"test: %v\n\tExp: %v\n\tGot: %v\n", i, tc.expProvisions, provisions)
+	}
+}
@@ -0,0 +1,25 @@
+package mint
+
+import (
+	"fmt"
+)
+
+// mint parameters
+type Params struct {
+	BlocksPerYear int64 `json:"blocks_per_year"` // expected blocks per year
+}
+
+// default minting module parameters
+func DefaultParams() Params {
+	return Params{
+		BlocksPerYear: 60 * 60 * 8766 / 5, // assuming 5 second block times
+	}
+}
+
+// validate the minting parameters
+func (p Params) Validate() error {
+	if p.BlocksPerYear <= 0 {
+		return fmt.Errorf("mint blocks per year must be positive, is %d", p.BlocksPerYear)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case "inflation":
+			return queryInflation(ctx, keeper)
+		case "annual_provisions":
+			return queryAnnualProvisions(ctx, keeper)
+		case "parameters":
+			return queryParams(ctx, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown mint query endpoint")
+		}
+	}
+}
+
+func queryInflation(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	inflation := keeper.GetMinter(ctx).Inflation
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, inflation)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryAnnualProvisions(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	annualProvisions := keeper.GetMinter(ctx).AnnualProvisions
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, annualProvisions)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
+
+func queryParams(ctx sdk.Context, keeper Keeper) (res []byte, err sdk.Error) {
+	params := keeper.GetParams(ctx)
+
+	bz, err2 := wire.MarshalJSONIndent(keeper.cdc, params)
+	if err2 != nil {
+		panic("could not marshal result to JSON")
+	}
+	return bz, nil
+}
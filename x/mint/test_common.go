@@ -0,0 +1,60 @@
+package mint
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/wire"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/stake"
+)
+
+func createTestCodec() *wire.Codec {
+	cdc := wire.NewCodec()
+	sdk.RegisterWire(cdc)
+	auth.RegisterWire(cdc)
+	bank.RegisterWire(cdc)
+	stake.RegisterWire(cdc)
+	wire.RegisterCrypto(cdc)
+	return cdc
+}
+
+func createTestInput(t *testing.T, looseTokens int64) (sdk.Context, stake.Keeper, bank.SupplyKeeper, auth.FeeCollectionKeeper, Keeper) {
+	keyAcc := sdk.NewKVStoreKey("acc")
+	keyBank := sdk.NewKVStoreKey("bank")
+	keyStake := sdk.NewKVStoreKey("stake")
+	keyMint := sdk.NewKVStoreKey("mint")
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyBank, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStake, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyMint, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{}, false, log.NewTMLogger(os.Stdout))
+	cdc := createTestCodec()
+	accountMapper := auth.NewAccountMapper(cdc, keyAcc, auth.ProtoBaseAccount)
+	supk := bank.NewSupplyKeeper(cdc, keyBank)
+	ck := bank.NewKeeper(accountMapper).WithSupplyKeeper(supk)
+	fck := auth.NewFeeCollectionKeeper(accountMapper)
+	sk := stake.NewKeeper(cdc, keyStake, ck, stake.DefaultCodespace)
+	keeper := NewKeeper(cdc, keyMint, sk, ck, fck)
+
+	genesis := stake.DefaultGenesisState()
+	genesis.Pool.LooseTokens = sdk.NewDec(looseTokens)
+	_, err = stake.InitGenesis(ctx, sk, genesis)
+	require.Nil(t, err)
+
+	InitGenesis(ctx, keeper, DefaultGenesisState())
+	return ctx, sk, supk, fck, keeper
+}
@@ -0,0 +1,29 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// mint begin block functionality, updates the inflation rate and annual
+// provisions and mints the provisions of the block into the fee collector,
+// from where they are distributed along with the collected fees
+func BeginBlocker(ctx sdk.Context, k Keeper) {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+	stakeParams := k.stakeKeeper.GetParams(ctx)
+	pool := k.stakeKeeper.GetPool(ctx)
+
+	minter.Inflation = minter.NextInflationRate(stakeParams, pool.BondedRatio(), params.BlocksPerYear)
+	minter.AnnualProvisions = minter.NextAnnualProvisions(pool.TokenSupply())
+	k.SetMinter(ctx, minter)
+
+	mintedCoin := minter.BlockProvision(stakeParams.BondDenom, params.BlocksPerYear)
+	if mintedCoin.IsZero() {
+		return
+	}
+
+	// the minted tokens are loose until they are withdrawn and bonded
+	k.feeCollectionKeeper.AddCollectedFees(ctx, sdk.Coins{mintedCoin})
+	k.stakeKeeper.InflateSupply(ctx, sdk.NewDecFromInt(mintedCoin.Amount))
+	k.bankKeeper.InflateSupply(ctx, sdk.Coins{mintedCoin})
+}
@@ -0,0 +1,51 @@
+package mint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestBeginBlockerMintsProvisions(t *testing.T) {
+	ctx, sk, supk, fck, keeper := createTestInput(t, 1000000000)
+	params := keeper.GetParams(ctx)
+	bondDenom := sk.GetParams(ctx).BondDenom
+
+	BeginBlocker(ctx, keeper)
+
+	// with no bonded tokens the inflation rises towards its maximum
+	minter := keeper.GetMinter(ctx)
+	require.True(t, minter.Inflation.GT(InitialMinter().Inflation))
+	expAnnualProvisions := minter.Inflation.Mul(sdk.NewDec(1000000000))
+	require.True(t, expAnnualProvisions.Equal(minter.AnnualProvisions))
+
+	// the block provision is collected as fees and added to the loose tokens
+	// and the total supply
+	expProvision := minter.BlockProvision(bondDenom, params.BlocksPerYear)
+	require.True(t, expProvision.IsPositive())
+	require.Equal(t, sdk.Coins{expProvision}, fck.GetCollectedFees(ctx))
+	require.Equal(t, sdk.Coins{expProvision}, supk.GetSupply(ctx))
+	pool := sk.GetPool(ctx)
+	require.True(t, pool.LooseTokens.Equal(sdk.NewDec(1000000000).Add(sdk.NewDecFromInt(expProvision.Amount))))
+
+	// provisions keep being collected in the following blocks
+	BeginBlocker(ctx, keeper)
+	collected := fck.GetCollectedFees(ctx)
+	require.True(t, collected.AmountOf(bondDenom).GT(expProvision.Amount))
+	pool = sk.GetPool(ctx)
+	require.True(t, pool.LooseTokens.Equal(sdk.NewDec(1000000000).Add(sdk.NewDecFromInt(collected.AmountOf(bondDenom)))))
+}
+
+func TestBeginBlockerNoSupply(t *testing.T) {
+	ctx, sk, supk, fck, keeper := createTestInput(t, 0)
+
+	BeginBlocker(ctx, keeper)
+
+	// nothing is minted without a token supply
+	require.True(t, keeper.GetMinter(ctx).AnnualProvisions.IsZero())
+	require.True(t, fck.GetCollectedFees(ctx).IsZero())
+	require.True(t, supk.GetSupply(ctx).IsZero())
+	require.True(t, sk.GetPool(ctx).LooseTokens.IsZero())
+}
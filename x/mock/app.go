@@ -62,6 +62,7 @@ func NewApp() *App {
 		app.KeyAccount,
 		auth.ProtoBaseAccount,
 	)
+	app.FeeCollectionKeeper = auth.NewFeeCollectionKeeper(app.AccountMapper)
 
 	// Initialize the app. The chainers and blockers can be overwritten before
 	// calling complete setup.
@@ -141,7 +142,7 @@ func GenTx(msgs []sdk.Msg, accnums []int64, seq []int64, priv ...crypto.PrivKey)
 	memo := "testmemotestmemo"
 
 	for i, p := range priv {
-		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo))
+		sig, err := p.Sign(auth.StdSignBytes(chainID, accnums[i], seq[i], fee, msgs, memo, 0))
 		if err != nil {
 			panic(err)
 		}
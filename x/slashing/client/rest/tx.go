@@ -33,6 +33,7 @@ type UnjailBody struct {
 	AccountNumber    int64  `json:"account_number"`
 	Sequence         int64  `json:"sequence"`
 	Gas              int64  `json:"gas"`
+	TimeoutHeight    int64  `json:"timeout_height"`
 	ValidatorAddr    string `json:"validator_addr"`
 }
 
@@ -73,6 +74,7 @@ func unjailRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx context.CLI
 			AccountNumber: m.AccountNumber,
 			Sequence:      m.Sequence,
 			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
 		}
 
 		msg := slashing.NewMsgUnjail(validatorAddr)
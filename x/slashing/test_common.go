@@ -106,5 +106,6 @@ func newTestMsgCreateValidator(address sdk.AccAddress, pubKey crypto.PubKey, amt
 		ValidatorAddr: address,
 		PubKey:        pubKey,
 		Delegation:    sdk.Coin{"steak", amt},
+		Commission:    stake.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 	}
 }
@@ -21,6 +21,10 @@ const (
 	FlagIdentity = "identity"
 	FlagWebsite  = "website"
 	FlagDetails  = "details"
+
+	FlagCommissionRate          = "commission-rate"
+	FlagCommissionMaxRate       = "commission-max-rate"
+	FlagCommissionMaxChangeRate = "commission-max-change-rate"
 )
 
 // common flagsets to add to various functions
@@ -30,6 +34,8 @@ var (
 	fsShares            = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionCreate = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDescriptionEdit   = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommissionCreate  = flag.NewFlagSet("", flag.ContinueOnError)
+	fsCommissionUpdate  = flag.NewFlagSet("", flag.ContinueOnError)
 	fsValidator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsDelegator         = flag.NewFlagSet("", flag.ContinueOnError)
 	fsRedelegation      = flag.NewFlagSet("", flag.ContinueOnError)
@@ -48,6 +54,10 @@ func init() {
 	fsDescriptionEdit.String(FlagIdentity, types.DoNotModifyDesc, "optional identity signature (ex. UPort or Keybase)")
 	fsDescriptionEdit.String(FlagWebsite, types.DoNotModifyDesc, "optional website")
 	fsDescriptionEdit.String(FlagDetails, types.DoNotModifyDesc, "optional details")
+	fsCommissionCreate.String(FlagCommissionRate, "0", "initial commission rate charged to delegators")
+	fsCommissionCreate.String(FlagCommissionMaxRate, "0", "maximum commission rate which the validator can ever charge")
+	fsCommissionCreate.String(FlagCommissionMaxChangeRate, "0", "maximum change of the commission rate per update")
+	fsCommissionUpdate.String(FlagCommissionRate, "", "new commission rate, may be changed once every 24h")
 	fsValidator.String(FlagAddressValidator, "", "hex address of the validator")
 	fsDelegator.String(FlagAddressDelegator, "", "hex address of the delegator")
 	fsRedelegation.String(FlagAddressValidatorSrc, "", "hex address of the source validator")
@@ -66,6 +66,15 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 				Details:  viper.GetString(FlagDetails),
 			}
 
+			commission, err := buildCommissionMsg(
+				viper.GetString(FlagCommissionRate),
+				viper.GetString(FlagCommissionMaxRate),
+				viper.GetString(FlagCommissionMaxChangeRate),
+			)
+			if err != nil {
+				return err
+			}
+
 			var msg sdk.Msg
 			if viper.GetString(FlagAddressDelegator) != "" {
 				delegatorAddr, err := sdk.AccAddressFromBech32(viper.GetString(FlagAddressDelegator))
@@ -73,9 +82,9 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 					return err
 				}
 
-				msg = stake.NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr, pk, amount, description)
+				msg = stake.NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr, pk, amount, description, commission)
 			} else {
-				msg = stake.NewMsgCreateValidator(validatorAddr, pk, amount, description)
+				msg = stake.NewMsgCreateValidator(validatorAddr, pk, amount, description, commission)
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
@@ -86,11 +95,29 @@ func GetCmdCreateValidator(cdc *wire.Codec) *cobra.Command {
 	cmd.Flags().AddFlagSet(fsPk)
 	cmd.Flags().AddFlagSet(fsAmount)
 	cmd.Flags().AddFlagSet(fsDescriptionCreate)
+	cmd.Flags().AddFlagSet(fsCommissionCreate)
 	cmd.Flags().AddFlagSet(fsDelegator)
 
 	return cmd
 }
 
+// buildCommissionMsg parses the commission flags of a new validator.
+func buildCommissionMsg(rateStr, maxRateStr, maxChangeRateStr string) (commission types.CommissionMsg, err error) {
+	rate, err := sdk.NewDecFromStr(rateStr)
+	if err != nil {
+		return commission, errors.Errorf("invalid commission rate: %v", err)
+	}
+	maxRate, err := sdk.NewDecFromStr(maxRateStr)
+	if err != nil {
+		return commission, errors.Errorf("invalid max commission rate: %v", err)
+	}
+	maxChangeRate, err := sdk.NewDecFromStr(maxChangeRateStr)
+	if err != nil {
+		return commission, errors.Errorf("invalid max commission change rate: %v", err)
+	}
+	return types.NewCommissionMsg(rate, maxRate, maxChangeRate), nil
+}
+
 // GetCmdEditValidator implements the create edit validator command.
 func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -114,7 +141,17 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 				Website:  viper.GetString(FlagWebsite),
 				Details:  viper.GetString(FlagDetails),
 			}
-			msg := stake.NewMsgEditValidator(validatorAddr, description)
+
+			var newRate *sdk.Dec
+			if rateStr := viper.GetString(FlagCommissionRate); rateStr != "" {
+				rate, err := sdk.NewDecFromStr(rateStr)
+				if err != nil {
+					return errors.Errorf("invalid commission rate: %v", err)
+				}
+				newRate = &rate
+			}
+
+			msg := stake.NewMsgEditValidator(validatorAddr, description, newRate)
 
 			// build and sign the transaction, then broadcast to Tendermint
 			return utils.SendTx(txCtx, cliCtx, []sdk.Msg{msg})
@@ -122,6 +159,7 @@ func GetCmdEditValidator(cdc *wire.Codec) *cobra.Command {
 	}
 
 	cmd.Flags().AddFlagSet(fsDescriptionEdit)
+	cmd.Flags().AddFlagSet(fsCommissionUpdate)
 
 	return cmd
 }
@@ -60,6 +60,7 @@ type EditDelegationsBody struct {
 	AccountNumber       int64                        `json:"account_number"`
 	Sequence            int64                        `json:"sequence"`
 	Gas                 int64                        `json:"gas"`
+	TimeoutHeight       int64                        `json:"timeout_height"`
 	Delegations         []msgDelegationsInput        `json:"delegations"`
 	BeginUnbondings     []msgBeginUnbondingInput     `json:"begin_unbondings"`
 	CompleteUnbondings  []msgCompleteUnbondingInput  `json:"complete_unbondings"`
@@ -261,9 +262,10 @@ func delegationsRequestHandlerFn(cdc *wire.Codec, kb keys.Keybase, cliCtx contex
 		}
 
 		txCtx := authcliCtx.TxContext{
-			Codec:   cdc,
-			ChainID: m.ChainID,
-			Gas:     m.Gas,
+			Codec:         cdc,
+			ChainID:       m.ChainID,
+			Gas:           m.Gas,
+			TimeoutHeight: m.TimeoutHeight,
 		}
 
 		// sign messages
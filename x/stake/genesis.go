@@ -12,17 +12,19 @@ import (
 // InitGenesis sets the pool and parameters for the provided keeper and
 // initializes the IntraTxCounter. For each validator in data, it sets that
 // validator in the keeper along with manually setting the indexes. In
-// addition, it also sets any delegations found in data. Finally, it updates
-// the bonded validators.
+// addition, it also sets any delegations found in data and counts the tokens
+// held by the validators. Finally, it updates the bonded validators.
 // Returns final validator set after applying all declaration and delegations
 func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res []abci.Validator, err error) {
 	keeper.SetPool(ctx, data.Pool)
 	keeper.SetNewParams(ctx, data.Params)
 	keeper.InitIntraTxCounter(ctx)
 
+	held := sdk.ZeroDec()
 	for i, validator := range data.Validators {
 		validator.BondIntraTxCounter = int16(i) // set the intra-tx counter to the order the validators are presented
 		keeper.SetValidator(ctx, validator)
+		held = held.Add(validator.Tokens)
 
 		if validator.Tokens.IsZero() {
 			return res, errors.Errorf("genesis validator cannot have zero pool shares, validator: %v", validator)
@@ -43,6 +45,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) (res [
 	for _, bond := range data.Bonds {
 		keeper.SetDelegation(ctx, bond)
 	}
+	keeper.SetHeldTokens(ctx, held.RoundInt())
 
 	keeper.UpdateBondedValidatorsFull(ctx)
 
@@ -1,8 +1,6 @@
 package stake
 
 import (
-	"time"
-
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/stake/keeper"
 	"github.com/cosmos/cosmos-sdk/x/stake/tags"
@@ -34,18 +32,8 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 	}
 }
 
-// Called every block, process inflation, update validator set
+// Called every block, update validator set
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) (ValidatorUpdates []abci.Validator) {
-	pool := k.GetPool(ctx)
-
-	// Process provision inflation
-	blockTime := ctx.BlockHeader().Time
-	if blockTime.Sub(pool.InflationLastTime) >= time.Hour {
-		params := k.GetParams(ctx)
-		pool.InflationLastTime = blockTime
-		pool = pool.ProcessProvisions(params)
-		k.SetPool(ctx, pool)
-	}
 
 	// reset the intra-transaction counter
 	k.SetIntraTxCounter(ctx, 0)
@@ -77,12 +65,16 @@ func handleMsgCreateValidator(ctx sdk.Context, msg types.MsgCreateValidator, k k
 	}
 
 	validator := NewValidator(msg.ValidatorAddr, msg.PubKey, msg.Description)
+	validator, err := validator.SetInitialCommission(msg.Commission, ctx.BlockHeader().Time)
+	if err != nil {
+		return err.Result()
+	}
 	k.SetValidator(ctx, validator)
 	k.SetValidatorByPubKeyIndex(ctx, validator)
 
 	// move coins from the msg.Address account to a (self-delegation) delegator account
 	// the validator account and global shares are updated within here
-	_, err := k.Delegate(ctx, msg.DelegatorAddr, msg.Delegation, validator, true)
+	_, err = k.Delegate(ctx, msg.DelegatorAddr, msg.Delegation, validator, true)
 	if err != nil {
 		return err.Result()
 	}
@@ -107,20 +99,30 @@ func handleMsgEditValidator(ctx sdk.Context, msg types.MsgEditValidator, k keepe
 	}
 
 	// replace all editable fields (clients should autofill existing values)
-	description, err := validator.Description.UpdateDescription(msg.Description)
-	if err != nil {
-		return err.Result()
+	if msg.Description != (Description{}) {
+		description, err := validator.Description.UpdateDescription(msg.Description)
+		if err != nil {
+			return err.Result()
+		}
+		validator.Description = description
+	}
+
+	if msg.CommissionRate != nil {
+		var err sdk.Error
+		validator, err = validator.UpdateCommission(*msg.CommissionRate, ctx.BlockHeader().Time)
+		if err != nil {
+			return err.Result()
+		}
 	}
-	validator.Description = description
 
 	// We don't need to run through all the power update logic within k.UpdateValidator
-	// We just need to override the entry in state, since only the description has changed.
+	// We just need to override the entry in state, since only the description and commission have changed.
 	k.SetValidator(ctx, validator)
 	tags := sdk.NewTags(
 		tags.Action, tags.ActionEditValidator,
 		tags.DstValidator, []byte(msg.ValidatorAddr.String()),
-		tags.Moniker, []byte(description.Moniker),
-		tags.Identity, []byte(description.Identity),
+		tags.Moniker, []byte(validator.Description.Moniker),
+		tags.Identity, []byte(validator.Description.Identity),
 	)
 	return sdk.Result{
 		Tags: tags,
@@ -16,8 +16,10 @@ import (
 
 //______________________________________________________________________
 
+var commissionMsg = types.NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+
 func newTestMsgCreateValidator(address sdk.AccAddress, pubKey crypto.PubKey, amt int64) MsgCreateValidator {
-	return types.NewMsgCreateValidator(address, pubKey, sdk.Coin{"steak", sdk.NewInt(amt)}, Description{})
+	return types.NewMsgCreateValidator(address, pubKey, sdk.Coin{"steak", sdk.NewInt(amt)}, Description{}, commissionMsg)
 }
 
 func newTestMsgDelegate(delegatorAddr, validatorAddr sdk.AccAddress, amt int64) MsgDelegate {
@@ -35,6 +37,7 @@ func newTestMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAdd
 		ValidatorAddr: validatorAddr,
 		PubKey:        valPubKey,
 		Delegation:    sdk.Coin{"steak", sdk.NewInt(amt)},
+		Commission:    commissionMsg,
 	}
 }
 
@@ -99,11 +102,8 @@ func TestValidatorByPowerIndex(t *testing.T) {
 	require.True(t, keep.ValidatorByPowerIndexExists(ctx, keeper, power2))
 
 	// inflate a bunch
-	params := keeper.GetParams(ctx)
-	for i := 0; i < 200; i++ {
-		pool = pool.ProcessProvisions(params)
-		keeper.SetPool(ctx, pool)
-	}
+	keeper.InflateSupply(ctx, sdk.NewDec(1000000))
+	pool = keeper.GetPool(ctx)
 
 	// now the new record power index should be the same as the original record
 	power3 := GetValidatorsByPowerIndexKey(validator, pool)
@@ -193,6 +193,47 @@ func TestDuplicatesMsgCreateValidatorOnBehalfOf(t *testing.T) {
 	require.False(t, got.IsOK(), "%v", got)
 }
 
+func TestEditValidatorCommission(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	validatorAddr := keep.Addrs[0]
+
+	msgCreateValidator := newTestMsgCreateValidator(validatorAddr, keep.PKs[0], 10)
+	msgCreateValidator.Commission = types.NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	got := handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	// invalid commission parameters are rejected on creation
+	msgCreateValidator = newTestMsgCreateValidator(keep.Addrs[1], keep.PKs[1], 10)
+	msgCreateValidator.Commission = types.NewCommissionMsg(sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	got = handleMsgCreateValidator(ctx, msgCreateValidator, keeper)
+	require.False(t, got.IsOK(), "%v", got)
+
+	// the rate cannot be changed within a day of the creation
+	newRate := sdk.NewDecWithPrec(2, 1)
+	msgEditValidator := NewMsgEditValidator(validatorAddr, Description{}, &newRate)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "%v", got)
+
+	header := ctx.BlockHeader()
+	header.Time = header.Time.Add(types.CommissionUpdatePeriod)
+	ctx = ctx.WithBlockHeader(header)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.True(t, got.IsOK(), "%v", got)
+
+	validator, found := keeper.GetValidator(ctx, validatorAddr)
+	require.True(t, found)
+	require.True(sdk.DecEq(t, newRate, validator.Commission))
+	require.Equal(t, Description{}, validator.Description)
+
+	// the rate cannot be changed by more than the max change rate
+	header.Time = header.Time.Add(types.CommissionUpdatePeriod)
+	ctx = ctx.WithBlockHeader(header)
+	newRate = sdk.NewDecWithPrec(35, 2)
+	msgEditValidator = NewMsgEditValidator(validatorAddr, Description{}, &newRate)
+	got = handleMsgEditValidator(ctx, msgEditValidator, keeper)
+	require.False(t, got.IsOK(), "%v", got)
+}
+
 func TestIncrementsMsgDelegate(t *testing.T) {
 	initBond := int64(1000)
 	ctx, accMapper, keeper := keep.CreateTestInput(t, false, initBond)
@@ -893,3 +934,28 @@ func TestBondUnbondRedelegateSlashTwice(t *testing.T) {
 	_, found = keeper.GetValidator(ctx, valA)
 	require.False(t, found)
 }
+
+func TestHeldTokens(t *testing.T) {
+	ctx, _, keeper := keep.CreateTestInput(t, false, 1000)
+	_ = setInstantUnbondPeriod(keeper, ctx)
+	valAddr, delAddr := keep.Addrs[0], keep.Addrs[1]
+
+	// delegated coins are held by the stake module
+	got := handleMsgCreateValidator(ctx, newTestMsgCreateValidator(valAddr, keep.PKs[0], 100), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCreateValidator")
+	got = handleMsgDelegate(ctx, newTestMsgDelegate(delAddr, valAddr, 50), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgDelegate")
+	require.Equal(t, int64(150), keeper.GetHeldTokens(ctx).Int64())
+
+	// until the unbonding completes
+	got = handleMsgBeginUnbonding(ctx, NewMsgBeginUnbonding(delAddr, valAddr, sdk.NewDec(30)), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgBeginUnbonding")
+	require.Equal(t, int64(150), keeper.GetHeldTokens(ctx).Int64())
+	got = handleMsgCompleteUnbonding(ctx, NewMsgCompleteUnbonding(delAddr, valAddr), keeper)
+	require.True(t, got.IsOK(), "expected no error on runMsgCompleteUnbonding")
+	require.Equal(t, int64(120), keeper.GetHeldTokens(ctx).Int64())
+
+	// or they are burned by a slash
+	keeper.Slash(ctx, keep.PKs[0], ctx.BlockHeight(), 120, sdk.NewDecWithPrec(5, 1))
+	require.Equal(t, int64(60), keeper.GetHeldTokens(ctx).Int64())
+}
@@ -232,10 +232,16 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 
 	if subtractAccount {
 		// Account new shares, save
-		_, _, err = k.coinKeeper.SubtractCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
+		_, err = k.coinKeeper.DelegateCoins(ctx, delegation.DelegatorAddr, sdk.Coins{bondAmt})
 		if err != nil {
 			return
 		}
+		k.SetHeldTokens(ctx, k.GetHeldTokens(ctx).Add(bondAmt.Amount))
+	}
+
+	// call the appropriate hook if present
+	if found && k.hooks != nil {
+		k.hooks.BeforeDelegationSharesModified(ctx, delegatorAddr, validator.Operator)
 	}
 
 	pool := k.GetPool(ctx)
@@ -249,6 +255,10 @@ func (k Keeper) Delegate(ctx sdk.Context, delegatorAddr sdk.AccAddress, bondAmt
 	k.SetDelegation(ctx, delegation)
 	k.UpdateValidator(ctx, validator)
 
+	if !found && k.hooks != nil {
+		k.hooks.OnDelegationCreated(ctx, delegatorAddr, validator.Operator)
+	}
+
 	return
 }
 
@@ -276,6 +286,11 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 		return
 	}
 
+	// call the before-modification hook so pending state can be settled
+	if k.hooks != nil {
+		k.hooks.BeforeDelegationSharesModified(ctx, delegatorAddr, validatorAddr)
+	}
+
 	// subtract shares from delegator
 	delegation.Shares = delegation.Shares.Sub(shares)
 
@@ -288,6 +303,9 @@ func (k Keeper) unbond(ctx sdk.Context, delegatorAddr, validatorAddr sdk.AccAddr
 			validator.Jailed = true
 		}
 		k.RemoveDelegation(ctx, delegation)
+		if k.hooks != nil {
+			k.hooks.OnDelegationRemoved(ctx, delegatorAddr, validatorAddr)
+		}
 	} else {
 		// Update height
 		delegation.Height = ctx.BlockHeight()
@@ -355,10 +373,11 @@ func (k Keeper) CompleteUnbonding(ctx sdk.Context, delegatorAddr, validatorAddr
 		return types.ErrNotMature(k.Codespace(), "unbonding", "unit-time", ubd.MinTime, ctxTime)
 	}
 
-	_, _, err := k.coinKeeper.AddCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
+	_, err := k.coinKeeper.UndelegateCoins(ctx, ubd.DelegatorAddr, sdk.Coins{ubd.Balance})
 	if err != nil {
 		return err
 	}
+	k.SetHeldTokens(ctx, k.GetHeldTokens(ctx).Sub(ubd.Balance.Amount))
 	k.RemoveUnbondingDelegation(ctx, ubd)
 	return nil
 }
@@ -13,6 +13,7 @@ type Keeper struct {
 	storeKey   sdk.StoreKey
 	cdc        *wire.Codec
 	coinKeeper bank.Keeper
+	hooks      sdk.StakingHooks
 
 	// codespace
 	codespace sdk.CodespaceType
@@ -28,6 +29,15 @@ func NewKeeper(cdc *wire.Codec, key sdk.StoreKey, ck bank.Keeper, codespace sdk.
 	return keeper
 }
 
+// Set the validator hooks
+func (k Keeper) WithHooks(sh sdk.StakingHooks) Keeper {
+	if k.hooks != nil {
+		panic("cannot set validator hooks twice")
+	}
+	k.hooks = sh
+	return k
+}
+
 //_________________________________________________________________________
 
 // return the codespace
@@ -51,6 +61,11 @@ func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	return
 }
 
+// the denom of the staking tokens
+func (k Keeper) BondDenom(ctx sdk.Context) string {
+	return k.GetParams(ctx).BondDenom
+}
+
 // Need a distinct function because setParams depends on an existing previous
 // record of params to exist (to check if maxValidators has changed) - and we
 // panic on retrieval if it doesn't exist - hence if we use setParams for the very
@@ -94,6 +109,40 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.Pool) {
 	store.Set(PoolKey, b)
 }
 
+// get the staking tokens held outside of accounts, those bonded to validators
+// or in unbonding delegations, in the units counted by the total supply
+func (k Keeper) GetHeldTokens(ctx sdk.Context) (held sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(HeldTokensKey)
+	if b == nil {
+		return sdk.ZeroInt()
+	}
+	k.cdc.MustUnmarshalBinary(b, &held)
+	return
+}
+
+// set the staking tokens held outside of accounts
+func (k Keeper) SetHeldTokens(ctx sdk.Context, held sdk.Int) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshalBinary(held)
+	store.Set(HeldTokensKey, b)
+}
+
+// add newly minted tokens to the loose tokens of the pool
+func (k Keeper) InflateSupply(ctx sdk.Context, newTokens sdk.Dec) {
+	pool := k.GetPool(ctx)
+	pool.LooseTokens = pool.LooseTokens.Add(newTokens)
+	k.SetPool(ctx, pool)
+}
+
+// remove burned tokens, such as burned proposal deposits, from the loose
+// tokens of the pool
+func (k Keeper) BurnLooseTokens(ctx sdk.Context, burnedTokens sdk.Dec) {
+	pool := k.GetPool(ctx)
+	pool.LooseTokens = pool.LooseTokens.Sub(burnedTokens)
+	k.SetPool(ctx, pool)
+}
+
 //__________________________________________________________________________
 
 // get the current in-block validator operation counter
@@ -30,6 +30,7 @@ var (
 	RedelegationKey                  = []byte{0x0D} // key for a redelegation
 	RedelegationByValSrcIndexKey     = []byte{0x0E} // prefix for each key for an redelegation, by source validator owner
 	RedelegationByValDstIndexKey     = []byte{0x0F} // prefix for each key for an redelegation, by destination validator owner
+	HeldTokensKey                    = []byte{0x10} // key for the tokens held outside of accounts
 )
 
 const maxDigitsForAccount = 12 // ~220,000,000 atoms created at launch
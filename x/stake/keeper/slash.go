@@ -99,6 +99,7 @@ func (k Keeper) Slash(ctx sdk.Context, pubkey crypto.PubKey, infractionHeight in
 	pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
 	// update the pool
 	k.SetPool(ctx, pool)
+	k.deflateSupply(ctx, tokensToBurn.RoundInt())
 	// update the validator, possibly kicking it out
 	validator = k.UpdateValidator(ctx, validator)
 	// remove validator if it has been reduced to zero shares
@@ -183,6 +184,7 @@ func (k Keeper) slashUnbondingDelegation(ctx sdk.Context, unbondingDelegation ty
 		// Ref https://github.com/cosmos/cosmos-sdk/pull/1278#discussion_r198657760
 		pool.LooseTokens = pool.LooseTokens.Sub(slashAmount)
 		k.SetPool(ctx, pool)
+		k.deflateSupply(ctx, unbondingSlashAmount)
 	}
 
 	return
@@ -243,7 +245,17 @@ func (k Keeper) slashRedelegation(ctx sdk.Context, validator types.Validator, re
 		pool := k.GetPool(ctx)
 		pool.LooseTokens = pool.LooseTokens.Sub(tokensToBurn)
 		k.SetPool(ctx, pool)
+		k.deflateSupply(ctx, tokensToBurn.RoundInt())
 	}
 
 	return slashAmount
 }
+
+// remove the burned tokens from the held tokens and the total supply
+func (k Keeper) deflateSupply(ctx sdk.Context, burned sdk.Int) {
+	if burned.IsZero() {
+		return
+	}
+	k.SetHeldTokens(ctx, k.GetHeldTokens(ctx).Sub(burned))
+	k.coinKeeper.DeflateSupply(ctx, sdk.Coins{sdk.NewCoin(k.BondDenom(ctx), burned)})
+}
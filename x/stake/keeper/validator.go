@@ -636,6 +636,10 @@ func (k Keeper) RemoveValidator(ctx sdk.Context, address sdk.AccAddress) {
 	store.Delete(GetValidatorByPubKeyIndexKey(validator.PubKey))
 	store.Delete(GetValidatorsByPowerIndexKey(validator, pool))
 
+	if k.hooks != nil {
+		k.hooks.OnValidatorRemoved(ctx, address)
+	}
+
 	// delete from the current and power weighted validator groups if the validator
 	// is bonded - and add validator with zero power to the validator updates
 	if store.Get(GetValidatorsBondedIndexKey(validator.Operator)) == nil {
@@ -34,12 +34,19 @@ func SimulateMsgCreateValidator(m auth.AccountMapper, k stake.Keeper) simulation
 		if amount.Equal(sdk.ZeroInt()) {
 			return "no-operation", nil, nil
 		}
+		maxCommission := sdk.NewInt(10)
+		commission := stake.NewCommissionMsg(
+			sdk.NewDecWithPrec(simulation.RandomAmount(r, maxCommission).Int64(), 1),
+			sdk.OneDec(),
+			sdk.NewDecWithPrec(simulation.RandomAmount(r, maxCommission).Int64(), 1),
+		)
 		msg := stake.MsgCreateValidator{
 			Description:   description,
 			ValidatorAddr: address,
 			DelegatorAddr: address,
 			PubKey:        pubkey,
 			Delegation:    sdk.NewCoin(denom, amount),
+			Commission:    commission,
 		}
 		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 		ctx, write := ctx.CacheContext()
@@ -66,9 +73,11 @@ func SimulateMsgEditValidator(k stake.Keeper) simulation.Operation {
 		key := simulation.RandomKey(r, keys)
 		pubkey := key.PubKey()
 		address := sdk.AccAddress(pubkey.Address())
+		newCommissionRate := sdk.NewDecWithPrec(simulation.RandomAmount(r, sdk.NewInt(10)).Int64(), 1)
 		msg := stake.MsgEditValidator{
-			Description:   description,
-			ValidatorAddr: address,
+			Description:    description,
+			ValidatorAddr:  address,
+			CommissionRate: &newCommissionRate,
 		}
 		require.Nil(t, msg.ValidateBasic(), "expected msg to pass ValidateBasic: %s", msg.GetSignBytes())
 		ctx, write := ctx.CacheContext()
@@ -12,6 +12,7 @@ type (
 	Validator             = types.Validator
 	BechValidator         = types.BechValidator
 	Description           = types.Description
+	CommissionMsg         = types.CommissionMsg
 	Delegation            = types.Delegation
 	UnbondingDelegation   = types.UnbondingDelegation
 	Redelegation          = types.Redelegation
@@ -64,6 +65,7 @@ var (
 	InitialPool         = types.InitialPool
 	NewValidator        = types.NewValidator
 	NewDescription      = types.NewDescription
+	NewCommissionMsg    = types.NewCommissionMsg
 	NewGenesisState     = types.NewGenesisState
 	DefaultGenesisState = types.DefaultGenesisState
 	RegisterWire        = types.RegisterWire
@@ -100,6 +102,13 @@ var (
 	ErrCommissionNegative    = types.ErrCommissionNegative
 	ErrCommissionHuge        = types.ErrCommissionHuge
 
+	ErrCommissionEmpty               = types.ErrCommissionEmpty
+	ErrCommissionGTMaxRate           = types.ErrCommissionGTMaxRate
+	ErrCommissionChangeRateNegative  = types.ErrCommissionChangeRateNegative
+	ErrCommissionChangeRateGTMaxRate = types.ErrCommissionChangeRateGTMaxRate
+	ErrCommissionGTMaxChangeRate     = types.ErrCommissionGTMaxChangeRate
+	ErrCommissionUpdateTime          = types.ErrCommissionUpdateTime
+
 	ErrNilDelegatorAddr          = types.ErrNilDelegatorAddr
 	ErrBadDenom                  = types.ErrBadDenom
 	ErrBadDelegationAmount       = types.ErrBadDelegationAmount
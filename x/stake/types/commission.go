@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// minimum amount of block time between two commission rate changes
+const CommissionUpdatePeriod = 24 * time.Hour
+
+// CommissionMsg defines the commission parameters a validator is created
+// with. Only the rate may be changed afterwards.
+type CommissionMsg struct {
+	Rate          sdk.Dec `json:"rate"`            // the commission rate charged to delegators
+	MaxRate       sdk.Dec `json:"max_rate"`        // maximum commission rate which this validator can ever charge
+	MaxChangeRate sdk.Dec `json:"max_change_rate"` // maximum change of the commission rate per update
+}
+
+// NewCommissionMsg returns a new CommissionMsg with the provided values.
+func NewCommissionMsg(rate, maxRate, maxChangeRate sdk.Dec) CommissionMsg {
+	return CommissionMsg{
+		Rate:          rate,
+		MaxRate:       maxRate,
+		MaxChangeRate: maxChangeRate,
+	}
+}
+
+// Validate performs a stateless sanity check of the commission parameters.
+func (c CommissionMsg) Validate() sdk.Error {
+	switch {
+	case c.Rate.IsNil() || c.MaxRate.IsNil() || c.MaxChangeRate.IsNil():
+		return ErrCommissionEmpty(DefaultCodespace)
+	case c.MaxRate.LT(sdk.ZeroDec()):
+		return ErrCommissionNegative(DefaultCodespace)
+	case c.MaxRate.GT(sdk.OneDec()):
+		return ErrCommissionHuge(DefaultCodespace)
+	case c.Rate.LT(sdk.ZeroDec()):
+		return ErrCommissionNegative(DefaultCodespace)
+	case c.Rate.GT(c.MaxRate):
+		return ErrCommissionGTMaxRate(DefaultCodespace)
+	case c.MaxChangeRate.LT(sdk.ZeroDec()):
+		return ErrCommissionChangeRateNegative(DefaultCodespace)
+	case c.MaxChangeRate.GT(c.MaxRate):
+		return ErrCommissionChangeRateGTMaxRate(DefaultCodespace)
+	}
+	return nil
+}
+
+// String implements the Stringer interface for a CommissionMsg.
+func (c CommissionMsg) String() string {
+	return fmt.Sprintf("Rate: %v, MaxRate: %v, MaxChangeRate: %v", c.Rate, c.MaxRate, c.MaxChangeRate)
+}
+
+// SetInitialCommission sets the commission parameters of a newly created
+// validator. The creation counts as the first commission change.
+func (v Validator) SetInitialCommission(commission CommissionMsg, blockTime time.Time) (Validator, sdk.Error) {
+	if err := commission.Validate(); err != nil {
+		return v, err
+	}
+
+	v.Commission = commission.Rate
+	v.CommissionMax = commission.MaxRate
+	v.CommissionChangeRate = commission.MaxChangeRate
+	v.CommissionChangeTime = blockTime
+	return v, nil
+}
+
+// UpdateCommission changes the commission rate of a validator. The new rate
+// must stay within the validator's maximum rate, differ from the current rate
+// by no more than the maximum change rate, and at least a full update period
+// must have passed since the last change.
+func (v Validator) UpdateCommission(newRate sdk.Dec, blockTime time.Time) (Validator, sdk.Error) {
+	switch {
+	case blockTime.Sub(v.CommissionChangeTime) < CommissionUpdatePeriod:
+		return v, ErrCommissionUpdateTime(DefaultCodespace)
+	case newRate.LT(sdk.ZeroDec()):
+		return v, ErrCommissionNegative(DefaultCodespace)
+	case newRate.GT(v.CommissionMax):
+		return v, ErrCommissionGTMaxRate(DefaultCodespace)
+	case newRate.Sub(v.Commission).GT(v.CommissionChangeRate),
+		v.Commission.Sub(newRate).GT(v.CommissionChangeRate):
+		return v, ErrCommissionGTMaxChangeRate(DefaultCodespace)
+	}
+
+	v.Commission = newRate
+	v.CommissionChangeTime = blockTime
+	return v, nil
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestCommissionMsgValidate(t *testing.T) {
+	tests := []struct {
+		name                         string
+		rate, maxRate, maxChangeRate sdk.Dec
+		expectPass                   bool
+	}{
+		{"zero commission", sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec(), true},
+		{"basic good", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 2), true},
+		{"full commission", sdk.OneDec(), sdk.OneDec(), sdk.OneDec(), true},
+		{"empty commission", sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, false},
+		{"negative rate", sdk.NewDecWithPrec(-1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 2), false},
+		{"negative max rate", sdk.ZeroDec(), sdk.NewDecWithPrec(-1, 1), sdk.ZeroDec(), false},
+		{"max rate over 100%", sdk.ZeroDec(), sdk.NewDecWithPrec(11, 1), sdk.ZeroDec(), false},
+		{"rate over max rate", sdk.NewDecWithPrec(6, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 2), false},
+		{"negative change rate", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(-1, 2), false},
+		{"change rate over max rate", sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(6, 1), false},
+	}
+
+	for _, tc := range tests {
+		err := NewCommissionMsg(tc.rate, tc.maxRate, tc.maxChangeRate).Validate()
+		if tc.expectPass {
+			require.Nil(t, err, "test: %v", tc.name)
+		} else {
+			require.NotNil(t, err, "test: %v", tc.name)
+		}
+	}
+}
+
+func TestUpdateCommission(t *testing.T) {
+	createTime := time.Unix(0, 0).UTC()
+	commission := NewCommissionMsg(sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(1, 1))
+	val, err := NewValidator(addr1, pk1, Description{}).SetInitialCommission(commission, createTime)
+	require.Nil(t, err)
+	require.True(t, val.Commission.Equal(sdk.NewDecWithPrec(3, 1)))
+
+	nextDay := createTime.Add(CommissionUpdatePeriod)
+	tests := []struct {
+		name       string
+		newRate    sdk.Dec
+		blockTime  time.Time
+		expectPass bool
+	}{
+		{"within a day of the last change", sdk.NewDecWithPrec(35, 2), createTime.Add(time.Hour), false},
+		{"negative rate", sdk.NewDecWithPrec(-1, 2), nextDay, false},
+		{"over max rate", sdk.NewDecWithPrec(6, 1), nextDay, false},
+		{"increase over max change rate", sdk.NewDecWithPrec(45, 2), nextDay, false},
+		{"decrease over max change rate", sdk.NewDecWithPrec(15, 2), nextDay, false},
+		{"increase", sdk.NewDecWithPrec(4, 1), nextDay, true},
+		{"decrease", sdk.NewDecWithPrec(2, 1), nextDay, true},
+	}
+
+	for _, tc := range tests {
+		updated, err := val.UpdateCommission(tc.newRate, tc.blockTime)
+		if tc.expectPass {
+			require.Nil(t, err, "test: %v", tc.name)
+			require.True(t, updated.Commission.Equal(tc.newRate), "test: %v", tc.name)
+			require.True(t, updated.CommissionChangeTime.Equal(tc.blockTime), "test: %v", tc.name)
+		} else {
+			require.NotNil(t, err, "test: %v", tc.name)
+			require.True(t, updated.Commission.Equal(val.Commission), "test: %v", tc.name)
+		}
+	}
+}
@@ -24,29 +24,32 @@ type MsgCreateValidator struct {
 	ValidatorAddr sdk.AccAddress `json:"validator_address"`
 	PubKey        crypto.PubKey  `json:"pubkey"`
 	Delegation    sdk.Coin       `json:"delegation"`
+	Commission    CommissionMsg  `json:"commission"`
 }
 
 // Default way to create validator. Delegator address and validator address are the same
 func NewMsgCreateValidator(validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
-	selfDelegation sdk.Coin, description Description) MsgCreateValidator {
+	selfDelegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
 	return MsgCreateValidator{
 		Description:   description,
 		DelegatorAddr: validatorAddr,
 		ValidatorAddr: validatorAddr,
 		PubKey:        pubkey,
 		Delegation:    selfDelegation,
+		Commission:    commission,
 	}
 }
 
 // Creates validator msg by delegator address on behalf of validator address
 func NewMsgCreateValidatorOnBehalfOf(delegatorAddr, validatorAddr sdk.AccAddress, pubkey crypto.PubKey,
-	delegation sdk.Coin, description Description) MsgCreateValidator {
+	delegation sdk.Coin, description Description, commission CommissionMsg) MsgCreateValidator {
 	return MsgCreateValidator{
 		Description:   description,
 		DelegatorAddr: delegatorAddr,
 		ValidatorAddr: validatorAddr,
 		PubKey:        pubkey,
 		Delegation:    delegation,
+		Commission:    commission,
 	}
 }
 
@@ -72,11 +75,13 @@ func (msg MsgCreateValidator) GetSignBytes() []byte {
 		ValidatorAddr sdk.AccAddress `json:"validator_address"`
 		PubKey        string         `json:"pubkey"`
 		Delegation    sdk.Coin       `json:"delegation"`
+		Commission    CommissionMsg  `json:"commission"`
 	}{
 		Description:   msg.Description,
 		ValidatorAddr: msg.ValidatorAddr,
 		PubKey:        sdk.MustBech32ifyValPub(msg.PubKey),
 		Delegation:    msg.Delegation,
+		Commission:    msg.Commission,
 	})
 	if err != nil {
 		panic(err)
@@ -99,6 +104,9 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 	if msg.Description == empty {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "description must be included")
 	}
+	if err := msg.Commission.Validate(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -108,12 +116,16 @@ func (msg MsgCreateValidator) ValidateBasic() sdk.Error {
 type MsgEditValidator struct {
 	Description
 	ValidatorAddr sdk.AccAddress `json:"address"`
+
+	// the new commission rate, nil if the rate is left unchanged
+	CommissionRate *sdk.Dec `json:"commission_rate"`
 }
 
-func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description) MsgEditValidator {
+func NewMsgEditValidator(validatorAddr sdk.AccAddress, description Description, newRate *sdk.Dec) MsgEditValidator {
 	return MsgEditValidator{
-		Description:   description,
-		ValidatorAddr: validatorAddr,
+		Description:    description,
+		ValidatorAddr:  validatorAddr,
+		CommissionRate: newRate,
 	}
 }
 
@@ -127,10 +139,12 @@ func (msg MsgEditValidator) GetSigners() []sdk.AccAddress {
 func (msg MsgEditValidator) GetSignBytes() []byte {
 	b, err := MsgCdc.MarshalJSON(struct {
 		Description
-		ValidatorAddr sdk.AccAddress `json:"address"`
+		ValidatorAddr  sdk.AccAddress `json:"address"`
+		CommissionRate *sdk.Dec       `json:"commission_rate"`
 	}{
-		Description:   msg.Description,
-		ValidatorAddr: msg.ValidatorAddr,
+		Description:    msg.Description,
+		ValidatorAddr:  msg.ValidatorAddr,
+		CommissionRate: msg.CommissionRate,
 	})
 	if err != nil {
 		panic(err)
@@ -144,9 +158,17 @@ func (msg MsgEditValidator) ValidateBasic() sdk.Error {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "nil validator address")
 	}
 	empty := Description{}
-	if msg.Description == empty {
+	if msg.Description == empty && msg.CommissionRate == nil {
 		return sdk.NewError(DefaultCodespace, CodeInvalidInput, "transaction must include some information to modify")
 	}
+	if msg.CommissionRate != nil {
+		if msg.CommissionRate.IsNil() || msg.CommissionRate.LT(sdk.ZeroDec()) {
+			return ErrCommissionNegative(DefaultCodespace)
+		}
+		if msg.CommissionRate.GT(sdk.OneDec()) {
+			return ErrCommissionHuge(DefaultCodespace)
+		}
+	}
 	return nil
 }
 
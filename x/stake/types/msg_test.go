@@ -13,6 +13,8 @@ var (
 	coinPos  = sdk.NewInt64Coin("steak", 1000)
 	coinZero = sdk.NewInt64Coin("steak", 0)
 	coinNeg  = sdk.NewInt64Coin("steak", -10000)
+
+	commissionMsg = NewCommissionMsg(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
 )
 
 // test ValidateBasic for MsgCreateValidator
@@ -36,7 +38,7 @@ func TestMsgCreateValidator(t *testing.T) {
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description)
+		msg := NewMsgCreateValidator(tc.validatorAddr, tc.pubkey, tc.bond, description, commissionMsg)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -45,6 +47,21 @@ func TestMsgCreateValidator(t *testing.T) {
 	}
 }
 
+// test commission validation of MsgCreateValidator
+func TestMsgCreateValidatorCommission(t *testing.T) {
+	description := NewDescription("a", "b", "c", "d")
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, description, CommissionMsg{})
+	require.NotNil(t, msg.ValidateBasic())
+
+	commission := NewCommissionMsg(sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 1), sdk.ZeroDec())
+	msg = NewMsgCreateValidator(addr1, pk1, coinPos, description, commission)
+	require.NotNil(t, msg.ValidateBasic())
+
+	commission = NewCommissionMsg(sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(1, 2))
+	msg = NewMsgCreateValidator(addr1, pk1, coinPos, description, commission)
+	require.Nil(t, msg.ValidateBasic())
+}
+
 // test ValidateBasic for MsgEditValidator
 func TestMsgEditValidator(t *testing.T) {
 	tests := []struct {
@@ -60,13 +77,22 @@ func TestMsgEditValidator(t *testing.T) {
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgEditValidator(tc.validatorAddr, description)
+		msg := NewMsgEditValidator(tc.validatorAddr, description, nil)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		}
 	}
+
+	// only the commission rate may be edited
+	newRate := sdk.NewDecWithPrec(1, 1)
+	msg := NewMsgEditValidator(addr1, Description{}, &newRate)
+	require.Nil(t, msg.ValidateBasic())
+
+	newRate = sdk.NewDecWithPrec(11, 1)
+	msg = NewMsgEditValidator(addr1, Description{}, &newRate)
+	require.NotNil(t, msg.ValidateBasic())
 }
 
 // test ValidateBasic and GetSigners for MsgCreateValidatorOnBehalfOf
@@ -92,7 +118,7 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 
 	for _, tc := range tests {
 		description := NewDescription(tc.moniker, tc.identity, tc.website, tc.details)
-		msg := NewMsgCreateValidatorOnBehalfOf(tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, description)
+		msg := NewMsgCreateValidatorOnBehalfOf(tc.delegatorAddr, tc.validatorAddr, tc.validatorPubKey, tc.bond, description, commissionMsg)
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", tc.name)
 		} else {
@@ -100,11 +126,11 @@ func TestMsgCreateValidatorOnBehalfOf(t *testing.T) {
 		}
 	}
 
-	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{})
+	msg := NewMsgCreateValidator(addr1, pk1, coinPos, Description{}, commissionMsg)
 	addrs := msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{addr1}, addrs, "Signers on default msg is wrong")
 
-	msg = NewMsgCreateValidatorOnBehalfOf(addr2, addr1, pk1, coinPos, Description{})
+	msg = NewMsgCreateValidatorOnBehalfOf(addr2, addr1, pk1, coinPos, Description{}, commissionMsg)
 	addrs = msg.GetSigners()
 	require.Equal(t, []sdk.AccAddress{addr2, addr1}, addrs, "Signers for onbehalfof msg is wrong")
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"bytes"
 	"fmt"
+	"time"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
@@ -33,10 +34,10 @@ type Validator struct {
 	BondIntraTxCounter int16       `json:"bond_intra_tx_counter"` // block-local tx index of validator change
 	ProposerRewardPool sdk.Coins   `json:"proposer_reward_pool"`  // XXX reward pool collected from being the proposer
 
-	Commission            sdk.Dec `json:"commission"`              // XXX the commission rate of fees charged to any delegators
-	CommissionMax         sdk.Dec `json:"commission_max"`          // XXX maximum commission rate which this validator can ever charge
-	CommissionChangeRate  sdk.Dec `json:"commission_change_rate"`  // XXX maximum daily increase of the validator commission
-	CommissionChangeToday sdk.Dec `json:"commission_change_today"` // XXX commission rate change today, reset each day (UTC time)
+	Commission           sdk.Dec   `json:"commission"`             // the commission rate of fees charged to any delegators
+	CommissionMax        sdk.Dec   `json:"commission_max"`         // maximum commission rate which this validator can ever charge
+	CommissionChangeRate sdk.Dec   `json:"commission_change_rate"` // maximum change of the validator commission per update
+	CommissionChangeTime time.Time `json:"commission_change_time"` // block time of the last commission change
 
 	// fee related
 	LastBondedTokens sdk.Dec `json:"prev_bonded_tokens"` // Previous bonded tokens held
@@ -45,59 +46,59 @@ type Validator struct {
 // NewValidator - initialize a new validator
 func NewValidator(operator sdk.AccAddress, pubKey crypto.PubKey, description Description) Validator {
 	return Validator{
-		Operator:              operator,
-		PubKey:                pubKey,
-		Jailed:                false,
-		Status:                sdk.Unbonded,
-		Tokens:                sdk.ZeroDec(),
-		DelegatorShares:       sdk.ZeroDec(),
-		Description:           description,
-		BondHeight:            int64(0),
-		BondIntraTxCounter:    int16(0),
-		ProposerRewardPool:    sdk.Coins{},
-		Commission:            sdk.ZeroDec(),
-		CommissionMax:         sdk.ZeroDec(),
-		CommissionChangeRate:  sdk.ZeroDec(),
-		CommissionChangeToday: sdk.ZeroDec(),
-		LastBondedTokens:      sdk.ZeroDec(),
+		Operator:             operator,
+		PubKey:               pubKey,
+		Jailed:               false,
+		Status:               sdk.Unbonded,
+		Tokens:               sdk.ZeroDec(),
+		DelegatorShares:      sdk.ZeroDec(),
+		Description:          description,
+		BondHeight:           int64(0),
+		BondIntraTxCounter:   int16(0),
+		ProposerRewardPool:   sdk.Coins{},
+		Commission:           sdk.ZeroDec(),
+		CommissionMax:        sdk.ZeroDec(),
+		CommissionChangeRate: sdk.ZeroDec(),
+		CommissionChangeTime: time.Unix(0, 0).UTC(),
+		LastBondedTokens:     sdk.ZeroDec(),
 	}
 }
 
 // what's kept in the store value
 type validatorValue struct {
-	PubKey                crypto.PubKey
-	Jailed                bool
-	Status                sdk.BondStatus
-	Tokens                sdk.Dec
-	DelegatorShares       sdk.Dec
-	Description           Description
-	BondHeight            int64
-	BondIntraTxCounter    int16
-	ProposerRewardPool    sdk.Coins
-	Commission            sdk.Dec
-	CommissionMax         sdk.Dec
-	CommissionChangeRate  sdk.Dec
-	CommissionChangeToday sdk.Dec
-	LastBondedTokens      sdk.Dec
+	PubKey               crypto.PubKey
+	Jailed               bool
+	Status               sdk.BondStatus
+	Tokens               sdk.Dec
+	DelegatorShares      sdk.Dec
+	Description          Description
+	BondHeight           int64
+	BondIntraTxCounter   int16
+	ProposerRewardPool   sdk.Coins
+	Commission           sdk.Dec
+	CommissionMax        sdk.Dec
+	CommissionChangeRate sdk.Dec
+	CommissionChangeTime time.Time
+	LastBondedTokens     sdk.Dec
 }
 
 // return the redelegation without fields contained within the key for the store
 func MustMarshalValidator(cdc *wire.Codec, validator Validator) []byte {
 	val := validatorValue{
-		PubKey:                validator.PubKey,
-		Jailed:                validator.Jailed,
-		Status:                validator.Status,
-		Tokens:                validator.Tokens,
-		DelegatorShares:       validator.DelegatorShares,
-		Description:           validator.Description,
-		BondHeight:            validator.BondHeight,
-		BondIntraTxCounter:    validator.BondIntraTxCounter,
-		ProposerRewardPool:    validator.ProposerRewardPool,
-		Commission:            validator.Commission,
-		CommissionMax:         validator.CommissionMax,
-		CommissionChangeRate:  validator.CommissionChangeRate,
-		CommissionChangeToday: validator.CommissionChangeToday,
-		LastBondedTokens:      validator.LastBondedTokens,
+		PubKey:               validator.PubKey,
+		Jailed:               validator.Jailed,
+		Status:               validator.Status,
+		Tokens:               validator.Tokens,
+		DelegatorShares:      validator.DelegatorShares,
+		Description:          validator.Description,
+		BondHeight:           validator.BondHeight,
+		BondIntraTxCounter:   validator.BondIntraTxCounter,
+		ProposerRewardPool:   validator.ProposerRewardPool,
+		Commission:           validator.Commission,
+		CommissionMax:        validator.CommissionMax,
+		CommissionChangeRate: validator.CommissionChangeRate,
+		CommissionChangeTime: validator.CommissionChangeTime,
+		LastBondedTokens:     validator.LastBondedTokens,
 	}
 	return cdc.MustMarshalBinary(val)
 }
@@ -125,21 +126,21 @@ func UnmarshalValidator(cdc *wire.Codec, operatorAddr, value []byte) (validator
 	}
 
 	return Validator{
-		Operator:              operatorAddr,
-		PubKey:                storeValue.PubKey,
-		Jailed:                storeValue.Jailed,
-		Tokens:                storeValue.Tokens,
-		Status:                storeValue.Status,
-		DelegatorShares:       storeValue.DelegatorShares,
-		Description:           storeValue.Description,
-		BondHeight:            storeValue.BondHeight,
-		BondIntraTxCounter:    storeValue.BondIntraTxCounter,
-		ProposerRewardPool:    storeValue.ProposerRewardPool,
-		Commission:            storeValue.Commission,
-		CommissionMax:         storeValue.CommissionMax,
-		CommissionChangeRate:  storeValue.CommissionChangeRate,
-		CommissionChangeToday: storeValue.CommissionChangeToday,
-		LastBondedTokens:      storeValue.LastBondedTokens,
+		Operator:             operatorAddr,
+		PubKey:               storeValue.PubKey,
+		Jailed:               storeValue.Jailed,
+		Tokens:               storeValue.Tokens,
+		Status:               storeValue.Status,
+		DelegatorShares:      storeValue.DelegatorShares,
+		Description:          storeValue.Description,
+		BondHeight:           storeValue.BondHeight,
+		BondIntraTxCounter:   storeValue.BondIntraTxCounter,
+		ProposerRewardPool:   storeValue.ProposerRewardPool,
+		Commission:           storeValue.Commission,
+		CommissionMax:        storeValue.CommissionMax,
+		CommissionChangeRate: storeValue.CommissionChangeRate,
+		CommissionChangeTime: storeValue.CommissionChangeTime,
+		LastBondedTokens:     storeValue.LastBondedTokens,
 	}, nil
 }
 
@@ -165,7 +166,7 @@ func (v Validator) HumanReadableString() (string, error) {
 	resp += fmt.Sprintf("Commission: %s\n", v.Commission.String())
 	resp += fmt.Sprintf("Max Commission Rate: %s\n", v.CommissionMax.String())
 	resp += fmt.Sprintf("Commission Change Rate: %s\n", v.CommissionChangeRate.String())
-	resp += fmt.Sprintf("Commission Change Today: %s\n", v.CommissionChangeToday.String())
+	resp += fmt.Sprintf("Commission Change Time: %v\n", v.CommissionChangeTime)
 	resp += fmt.Sprintf("Previous Bonded Tokens: %s\n", v.LastBondedTokens.String())
 
 	return resp, nil
@@ -188,10 +189,10 @@ type BechValidator struct {
 	BondIntraTxCounter int16       `json:"bond_intra_tx_counter"` // block-local tx index of validator change
 	ProposerRewardPool sdk.Coins   `json:"proposer_reward_pool"`  // XXX reward pool collected from being the proposer
 
-	Commission            sdk.Dec `json:"commission"`              // XXX the commission rate of fees charged to any delegators
-	CommissionMax         sdk.Dec `json:"commission_max"`          // XXX maximum commission rate which this validator can ever charge
-	CommissionChangeRate  sdk.Dec `json:"commission_change_rate"`  // XXX maximum daily increase of the validator commission
-	CommissionChangeToday sdk.Dec `json:"commission_change_today"` // XXX commission rate change today, reset each day (UTC time)
+	Commission           sdk.Dec   `json:"commission"`             // the commission rate of fees charged to any delegators
+	CommissionMax        sdk.Dec   `json:"commission_max"`         // maximum commission rate which this validator can ever charge
+	CommissionChangeRate sdk.Dec   `json:"commission_change_rate"` // maximum change of the validator commission per update
+	CommissionChangeTime time.Time `json:"commission_change_time"` // block time of the last commission change
 
 	// fee related
 	LastBondedTokens sdk.Dec `json:"prev_bonded_shares"` // last bonded token amount
@@ -218,10 +219,10 @@ func (v Validator) Bech32Validator() (BechValidator, error) {
 		BondIntraTxCounter: v.BondIntraTxCounter,
 		ProposerRewardPool: v.ProposerRewardPool,
 
-		Commission:            v.Commission,
-		CommissionMax:         v.CommissionMax,
-		CommissionChangeRate:  v.CommissionChangeRate,
-		CommissionChangeToday: v.CommissionChangeToday,
+		Commission:           v.Commission,
+		CommissionMax:        v.CommissionMax,
+		CommissionChangeRate: v.CommissionChangeRate,
+		CommissionChangeTime: v.CommissionChangeTime,
 
 		LastBondedTokens: v.LastBondedTokens,
 	}, nil
@@ -242,7 +243,7 @@ func (v Validator) Equal(c2 Validator) bool {
 		v.Commission.Equal(c2.Commission) &&
 		v.CommissionMax.Equal(c2.CommissionMax) &&
 		v.CommissionChangeRate.Equal(c2.CommissionChangeRate) &&
-		v.CommissionChangeToday.Equal(c2.CommissionChangeToday) &&
+		v.CommissionChangeTime.Equal(c2.CommissionChangeTime) &&
 		v.LastBondedTokens.Equal(c2.LastBondedTokens)
 }
 
@@ -438,3 +439,4 @@ func (v Validator) GetPower() sdk.Dec           { return v.BondedTokens() }
 func (v Validator) GetTokens() sdk.Dec          { return v.Tokens }
 func (v Validator) GetDelegatorShares() sdk.Dec { return v.DelegatorShares }
 func (v Validator) GetBondHeight() int64        { return v.BondHeight }
+func (v Validator) GetCommission() sdk.Dec      { return v.Commission }